@@ -0,0 +1,150 @@
+package zorm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// isArrayFieldType reports whether t can back a field tagged `array`.
+func isArrayFieldType(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+	switch t.Elem().Kind() {
+	case reflect.String, reflect.Int, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// arrayValue binds a field tagged `array` as a PostgreSQL array literal,
+// e.g. {"a","b"} or {1,2}. A nil slice is stored as NULL. Other dialects
+// have no array type, so binding fails there.
+type arrayValue struct {
+	v       reflect.Value
+	dialect Dialect
+}
+
+// Value implements driver.Valuer.
+func (a arrayValue) Value() (driver.Value, error) {
+	if a.dialect != DialectPostgres {
+		return nil, fmt.Errorf("zorm: array columns require PostgreSQL, got %s", a.dialect)
+	}
+	if a.v.IsNil() {
+		return nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i < a.v.Len(); i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		elem := a.v.Index(i)
+		if elem.Kind() == reflect.String {
+			sb.WriteByte('"')
+			for _, r := range elem.String() {
+				if r == '"' || r == '\\' {
+					sb.WriteByte('\\')
+				}
+				sb.WriteRune(r)
+			}
+			sb.WriteByte('"')
+		} else {
+			sb.WriteString(strconv.FormatInt(elem.Int(), 10))
+		}
+	}
+	sb.WriteByte('}')
+	return sb.String(), nil
+}
+
+// arrayScanner decodes a one-dimensional PostgreSQL array literal into a
+// field tagged `array`. NULL leaves the field nil.
+type arrayScanner struct {
+	dst reflect.Value
+}
+
+// Scan implements sql.Scanner.
+func (s *arrayScanner) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case nil:
+		s.dst.Set(reflect.Zero(s.dst.Type()))
+		return nil
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return fmt.Errorf("zorm: cannot scan %T into array field of type %s", src, s.dst.Type())
+	}
+
+	elems, err := parseArrayLiteral(text)
+	if err != nil {
+		return err
+	}
+	out := reflect.MakeSlice(s.dst.Type(), len(elems), len(elems))
+	for i, e := range elems {
+		if out.Index(i).Kind() == reflect.String {
+			out.Index(i).SetString(e)
+			continue
+		}
+		n, err := strconv.ParseInt(e, 10, 64)
+		if err != nil {
+			return fmt.Errorf("zorm: array element %q is not an integer: %w", e, err)
+		}
+		out.Index(i).SetInt(n)
+	}
+	s.dst.Set(out)
+	return nil
+}
+
+// parseArrayLiteral splits a one-dimensional PostgreSQL array literal such
+// as {a,"b c","d\"e"} into its elements. NULL elements are rejected since
+// the supported field types cannot hold them.
+func parseArrayLiteral(text string) ([]string, error) {
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("zorm: invalid array literal %q", text)
+	}
+	body := text[1 : len(text)-1]
+	if body == "" {
+		return []string{}, nil
+	}
+
+	var elems []string
+	var sb strings.Builder
+	quoted, inQuotes, escaped := false, false, false
+	for _, r := range body {
+		switch {
+		case escaped:
+			sb.WriteRune(r)
+			escaped = false
+		case r == '\\' && inQuotes:
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+			quoted = true
+		case r == ',' && !inQuotes:
+			if !quoted && sb.String() == "NULL" {
+				return nil, fmt.Errorf("zorm: NULL element in array literal %q", text)
+			}
+			elems = append(elems, sb.String())
+			sb.Reset()
+			quoted = false
+		case r == '{' && !inQuotes:
+			return nil, fmt.Errorf("zorm: multi-dimensional array literal %q is not supported", text)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("zorm: unterminated quote in array literal %q", text)
+	}
+	if !quoted && sb.String() == "NULL" {
+		return nil, fmt.Errorf("zorm: NULL element in array literal %q", text)
+	}
+	return append(elems, sb.String()), nil
+}
@@ -0,0 +1,166 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type arrayModel struct {
+	ID     int      `zorm:"primaryKey"`
+	Labels []string `zorm:"array"`
+	Scores []int64  `zorm:"array"`
+}
+
+func (arrayModel) TableName() string { return "array_docs" }
+
+// setupArrayDB stores array columns as TEXT so the PostgreSQL literal
+// encoding can be exercised against sqlite.
+func setupArrayDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`CREATE TABLE array_docs (id INTEGER PRIMARY KEY AUTOINCREMENT, labels TEXT, scores TEXT)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	return db
+}
+
+func TestArrayColumn_RoundTrip(t *testing.T) {
+	db := setupArrayDB(t)
+	ctx := context.Background()
+
+	doc := &arrayModel{
+		Labels: []string{"go", "with space", `say "hi"`, "a,b", `back\slash`, ""},
+		Scores: []int64{3, -1, 42},
+	}
+	if err := New[arrayModel]().SetDB(db).SetDialect(DialectPostgres).Create(ctx, doc); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(doc)
+
+	var rawLabels, rawScores string
+	if err := db.QueryRow(`SELECT labels, scores FROM array_docs WHERE id = ?`, doc.ID).Scan(&rawLabels, &rawScores); err != nil {
+		t.Fatalf("failed to read raw values: %v", err)
+	}
+	if want := `{"go","with space","say \"hi\"","a,b","back\\slash",""}`; rawLabels != want {
+		t.Errorf("labels stored as %q, want %q", rawLabels, want)
+	}
+	if rawScores != "{3,-1,42}" {
+		t.Errorf("scores stored as %q, want %q", rawScores, "{3,-1,42}")
+	}
+
+	got, err := New[arrayModel]().SetDB(db).SetDialect(DialectPostgres).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	defer ClearOriginals(got)
+	if !reflect.DeepEqual(got.Labels, doc.Labels) {
+		t.Errorf("Labels = %q, want %q", got.Labels, doc.Labels)
+	}
+	if !reflect.DeepEqual(got.Scores, doc.Scores) {
+		t.Errorf("Scores = %v, want %v", got.Scores, doc.Scores)
+	}
+}
+
+func TestArrayColumn_SaveAfterInPlaceMutation(t *testing.T) {
+	db := setupArrayDB(t)
+	ctx := context.Background()
+
+	doc := &arrayModel{Labels: []string{"a", "b"}, Scores: []int64{1}}
+	if err := New[arrayModel]().SetDB(db).SetDialect(DialectPostgres).Create(ctx, doc); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(doc)
+
+	doc.Labels[1] = "c"
+	if err := New[arrayModel]().SetDB(db).SetDialect(DialectPostgres).Save(ctx, doc); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	var rawLabels string
+	if err := db.QueryRow(`SELECT labels FROM array_docs WHERE id = ?`, doc.ID).Scan(&rawLabels); err != nil {
+		t.Fatalf("failed to read raw value: %v", err)
+	}
+	if want := `{"a","c"}`; rawLabels != want {
+		t.Errorf("labels stored as %q, want %q", rawLabels, want)
+	}
+}
+
+func TestArrayColumn_NullAndEmpty(t *testing.T) {
+	db := setupArrayDB(t)
+	ctx := context.Background()
+
+	doc := &arrayModel{Labels: []string{}}
+	if err := New[arrayModel]().SetDB(db).SetDialect(DialectPostgres).Create(ctx, doc); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(doc)
+
+	got, err := New[arrayModel]().SetDB(db).SetDialect(DialectPostgres).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	defer ClearOriginals(got)
+	if got.Labels == nil || len(got.Labels) != 0 {
+		t.Errorf("expected an empty, non-nil Labels slice, got %#v", got.Labels)
+	}
+	if got.Scores != nil {
+		t.Errorf("expected NULL scores to scan as nil, got %#v", got.Scores)
+	}
+}
+
+func TestArrayColumn_RequiresPostgres(t *testing.T) {
+	db := setupArrayDB(t)
+	err := New[arrayModel]().SetDB(db).Create(context.Background(), &arrayModel{Labels: []string{"a"}})
+	if err == nil || !strings.Contains(err.Error(), "require PostgreSQL") {
+		t.Errorf("expected a dialect error, got %v", err)
+	}
+}
+
+func TestParseArrayLiteral(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{in: "{}", want: []string{}},
+		{in: "{a,b}", want: []string{"a", "b"}},
+		{in: `{"a b","c\"d",e}`, want: []string{"a b", `c"d`, "e"}},
+		{in: `{"NULL"}`, want: []string{"NULL"}},
+		{in: "{a,NULL}", wantErr: true},
+		{in: "{{1,2},{3,4}}", wantErr: true},
+		{in: `{"a}`, wantErr: true},
+		{in: "a,b", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseArrayLiteral(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseArrayLiteral(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseArrayLiteral(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestArrayTag_RejectsUnsupportedType(t *testing.T) {
+	type badArray struct {
+		ID    int
+		Flags []bool `zorm:"array"`
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expected ParseModel to panic for a []bool array field")
+		}
+	}()
+	ParseModel[badArray]()
+}
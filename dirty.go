@@ -421,6 +421,10 @@ func trackOriginalsWithScope[T any](entity *T, modelInfo *ModelInfo, scope *Trac
 
 	for _, field := range modelInfo.Fields {
 		fVal := val.FieldByIndex(field.Index)
+		if field.IsJSON || field.IsArray {
+			// Copy so in-place edits (meta["k"] = v) show up as changes
+			fVal = deepCopyValue(fVal)
+		}
 		originals[field.Column] = fVal.Interface()
 	}
 
@@ -434,6 +438,80 @@ func trackOriginalsWithScope[T any](entity *T, modelInfo *ModelInfo, scope *Trac
 	}
 }
 
+// deepCopyValue returns a copy of v that shares no maps, slices or pointers
+// with it, recursing through interfaces and exported struct fields. It is
+// meant for the acyclic values held by JSON and array columns.
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(deepCopyValue(v.Elem()))
+		return p
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return s
+	case reflect.Array:
+		a := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			a.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return a
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		mp := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			mp.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+		return mp
+	case reflect.Struct:
+		st := reflect.New(v.Type()).Elem()
+		st.Set(v)
+		for i := 0; i < st.NumField(); i++ {
+			if f := st.Field(i); f.CanSet() {
+				f.Set(deepCopyValue(v.Field(i)))
+			}
+		}
+		return st
+	}
+	return v
+}
+
+// moveOriginals transfers the tracked originals of from to to, for callers
+// that copy a freshly loaded entity into a caller-owned struct.
+func moveOriginals[T any](from, to *T, scope *TrackingScope) {
+	tracker := globalTracker.Load()
+	fromKey := getEntityKey(from)
+	originals, ok := tracker.Load(fromKey)
+	if !ok {
+		return
+	}
+	tracker.Delete(fromKey)
+	toKey := getEntityKey(to)
+	tracker.Store(toKey, originals)
+	if scope != nil {
+		scope.track(toKey)
+	}
+}
+
 // ClearOriginals removes tracking for an entity.
 // Should be called when entity is deleted or no longer needed to prevent memory leaks.
 func ClearOriginals[T any](entity *T) {
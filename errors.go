@@ -6,12 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 )
 
 var (
 	// ErrRecordNotFound Query errors
 	// ErrRecordNotFound is returned when a query returns no results
 	ErrRecordNotFound = errors.New("zorm: record not found")
+	// ErrMultipleRecordsFound is returned by Sole when more than one row matches
+	ErrMultipleRecordsFound = errors.New("zorm: multiple records found")
 
 	// ErrInvalidModel Model errors
 	// ErrInvalidModel is returned when the model type is invalid
@@ -90,8 +93,31 @@ type QueryError struct {
 	Constraint string // The constraint name (if constraint violation)
 }
 
+// ErrorVerbosity controls how much query context QueryError.Error() includes.
+type ErrorVerbosity int32
+
+const (
+	// ErrorVerbose includes the SQL and its args in error messages (default).
+	ErrorVerbose ErrorVerbosity = iota
+	// ErrorRedactArgs includes the SQL but omits args, which may carry PII.
+	ErrorRedactArgs
+	// ErrorRedactAll omits both the SQL and the args.
+	ErrorRedactAll
+)
+
+// errorVerbosity is the package-wide ErrorVerbosity; zero is ErrorVerbose.
+var errorVerbosity atomic.Int32
+
+// SetErrorVerbosity sets how much query context is rendered into QueryError
+// messages. Redaction only affects Error(); the Query and Args fields and the
+// wrapped driver error are always kept for programmatic inspection.
+func SetErrorVerbosity(level ErrorVerbosity) { errorVerbosity.Store(int32(level)) }
+
+// GetErrorVerbosity returns the current package-wide ErrorVerbosity.
+func GetErrorVerbosity() ErrorVerbosity { return ErrorVerbosity(errorVerbosity.Load()) }
+
 func (e *QueryError) Error() string {
-	argsStr := formatArgs(e.Args)
+	level := GetErrorVerbosity()
 	var msg strings.Builder
 	msg.WriteString(fmt.Sprintf("zorm: %s failed: %v", e.Operation, e.Err))
 
@@ -102,8 +128,12 @@ func (e *QueryError) Error() string {
 		msg.WriteString(fmt.Sprintf("\nConstraint: %s", e.Constraint))
 	}
 
-	msg.WriteString(fmt.Sprintf("\nQuery: %s", e.Query))
-	msg.WriteString(fmt.Sprintf("\nArgs: %s", argsStr))
+	if level < ErrorRedactAll {
+		msg.WriteString(fmt.Sprintf("\nQuery: %s", e.Query))
+	}
+	if level < ErrorRedactArgs {
+		msg.WriteString(fmt.Sprintf("\nArgs: %s", formatArgs(e.Args)))
+	}
 
 	return msg.String()
 }
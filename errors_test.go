@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 )
 
@@ -213,6 +214,39 @@ func TestQueryError_Error(t *testing.T) {
 	}
 }
 
+// TestQueryError_ErrorVerbosity verifies SQL and args are redacted from the
+// message according to SetErrorVerbosity
+func TestQueryError_ErrorVerbosity(t *testing.T) {
+	t.Cleanup(func() { SetErrorVerbosity(ErrorVerbose) })
+
+	driverErr := errors.New("driver: unexpected packet")
+	qe := WrapQueryError("SELECT", "SELECT * FROM users WHERE email = $1", []any{"alice@example.com"}, driverErr)
+
+	SetErrorVerbosity(ErrorVerbose)
+	msg := qe.Error()
+	if !strings.Contains(msg, "alice@example.com") || !strings.Contains(msg, "FROM users") {
+		t.Errorf("verbose level should include SQL and args, got %q", msg)
+	}
+
+	SetErrorVerbosity(ErrorRedactArgs)
+	msg = qe.Error()
+	if strings.Contains(msg, "alice@example.com") {
+		t.Errorf("ErrorRedactArgs should omit args, got %q", msg)
+	}
+	if !strings.Contains(msg, "FROM users") || !strings.Contains(msg, "unexpected packet") {
+		t.Errorf("ErrorRedactArgs should keep SQL and driver error, got %q", msg)
+	}
+
+	SetErrorVerbosity(ErrorRedactAll)
+	msg = qe.Error()
+	if strings.Contains(msg, "alice@example.com") || strings.Contains(msg, "FROM users") {
+		t.Errorf("ErrorRedactAll should omit SQL and args, got %q", msg)
+	}
+	if !strings.Contains(msg, "unexpected packet") || !errors.Is(qe, driverErr) {
+		t.Errorf("ErrorRedactAll should keep the driver error, got %q", msg)
+	}
+}
+
 // TestQueryError_Unwrap verifies QueryError.Unwrap method
 func TestQueryError_Unwrap(t *testing.T) {
 	originalErr := ErrRecordNotFound
@@ -9,11 +9,14 @@ import (
 	"hash/fnv"
 	"maps"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // queryer returns the appropriate query executor based on transaction state and resolver configuration.
@@ -22,11 +25,12 @@ import (
 // Otherwise, it returns the database connection executor.
 // This allows the ORM to seamlessly work with both transactional and non-transactional contexts,
 // as well as primary/replica setups.
-func (m *Model[T]) queryer() interface {
-	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
-	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
-	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
-} {
+func (m *Model[T]) queryer() queryExecutor {
+	return withRecording(m.rawQueryer())
+}
+
+// rawQueryer is queryer without query recording.
+func (m *Model[T]) rawQueryer() queryExecutor {
 	// Transactions always use their own connection
 	if m.tx != nil {
 		return m.tx
@@ -67,11 +71,12 @@ func (m *Model[T]) resolveDB(resolver *DBResolver) *sql.DB {
 
 // queryerForWrite returns the primary database for write operations.
 // This should be used by Create, Update, Delete, and other write methods.
-func (m *Model[T]) queryerForWrite() interface {
-	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
-	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
-	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
-} {
+func (m *Model[T]) queryerForWrite() queryExecutor {
+	return withRecording(m.rawQueryerForWrite())
+}
+
+// rawQueryerForWrite is queryerForWrite without query recording.
+func (m *Model[T]) rawQueryerForWrite() queryExecutor {
 	// Transactions always use their own connection
 	if m.tx != nil {
 		return m.tx
@@ -92,11 +97,13 @@ func (m *Model[T]) queryerForWrite() interface {
 // prepareStmtWithQueryer prepares a statement using the cache.
 // Callers must only invoke this when m.stmtCache != nil.
 // It takes a queryer interface to allow reuse between read and write operations.
-func (m *Model[T]) prepareStmtWithQueryer(ctx context.Context, query string, q interface {
-	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
-	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
-	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
-}) (*sql.Stmt, func(), error) {
+func (m *Model[T]) prepareStmtWithQueryer(ctx context.Context, query string, q queryExecutor) (*sql.Stmt, func(), error) {
+	// Cached statements bypass the queryer, so record them here (without args).
+	if rq, ok := q.(recordingQueryer); ok {
+		recordQuery(query, nil)
+		q = rq.inner
+	}
+
 	// Try to get from cache
 	if stmt, release := m.stmtCache.Get(query); stmt != nil {
 		return stmt, release, nil
@@ -157,7 +164,7 @@ func (m *Model[T]) Get(ctx context.Context) ([]*T, error) {
 	if m.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = m.prepareStmt(ctx, rebind(query))
+		stmt, release, err = m.prepareStmt(ctx, m.rebind(query))
 		if err != nil {
 			return nil, WrapQueryError("PREPARE", query, args, err)
 		}
@@ -165,7 +172,7 @@ func (m *Model[T]) Get(ctx context.Context) ([]*T, error) {
 
 		rows, err = stmt.QueryContext(ctx, args...)
 	} else {
-		rows, err = m.queryer().QueryContext(ctx, rebind(query), args...)
+		rows, err = m.queryer().QueryContext(ctx, m.rebind(query), args...)
 	}
 
 	if err != nil {
@@ -181,6 +188,12 @@ func (m *Model[T]) Get(ctx context.Context) ([]*T, error) {
 	if err := m.loadRelations(ctx, results); err != nil {
 		return nil, err
 	}
+	if err := m.loadRelationCounts(ctx, results); err != nil {
+		return nil, err
+	}
+	if err := m.loadRelationAggregates(ctx, results); err != nil {
+		return nil, err
+	}
 
 	return results, nil
 }
@@ -201,17 +214,103 @@ func (m *Model[T]) First(ctx context.Context) (*T, error) {
 	return results[0], nil
 }
 
+// Sole returns the only record matching the query. It returns
+// ErrRecordNotFound when no row matches and ErrMultipleRecordsFound when more
+// than one does, instead of silently taking the first like First would.
+// At most two rows are fetched, and the original model's limit is untouched.
+func (m *Model[T]) Sole(ctx context.Context) (*T, error) {
+	// Clone to avoid mutating the original model's limit
+	q := m.Clone()
+	q.limit = 2
+	results, err := q.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, ErrRecordNotFound
+	case 1:
+		return results[0], nil
+	default:
+		return nil, ErrMultipleRecordsFound
+	}
+}
+
 // Find finds a record by ID.
 func (m *Model[T]) Find(ctx context.Context, id any) (*T, error) {
 	return m.Where(m.modelInfo.PrimaryKey, id).First(ctx)
 }
 
+// FindInto finds a record by ID like Find and copies it into dest, so
+// handlers can keep reusing pooled structs. Relations, hooks and dirty
+// tracking behave as in Find, with the tracked originals moved to dest.
+// Returns ErrRecordNotFound if no row matches, in which case dest is left
+// untouched.
+func (m *Model[T]) FindInto(ctx context.Context, id any, dest *T) error {
+	if dest == nil {
+		return fmt.Errorf("zorm: FindInto requires a non-nil destination")
+	}
+
+	found, err := m.Clone().Where(m.modelInfo.PrimaryKey, id).First(ctx)
+	if err != nil {
+		return err
+	}
+	*dest = *found
+	moveOriginals(found, dest, m.trackingScope)
+	return nil
+}
+
+// FindWith finds a record by ID and eager loads the given relations for it.
+// It is shorthand for m.With(relations...).Find(ctx, id).
+//
+// Example:
+//
+//	post, err := zorm.New[Post]().FindWith(ctx, 1, "User", "Comments")
+func (m *Model[T]) FindWith(ctx context.Context, id any, relations ...string) (*T, error) {
+	return m.With(relations...).Find(ctx, id)
+}
+
+// FindMany finds the records whose primary key is in ids, in no particular
+// order; IDs with no matching row are skipped. An empty ids returns an empty
+// slice without querying. Relations configured with With are eager loaded.
+//
+// Example:
+//
+//	users, err := zorm.New[User]().With("Posts").FindMany(ctx, []any{1, 2, 3})
+func (m *Model[T]) FindMany(ctx context.Context, ids []any) ([]*T, error) {
+	if len(ids) == 0 {
+		if m.buildErr != nil {
+			return nil, m.buildErr
+		}
+		return []*T{}, nil
+	}
+	return m.WhereIn(m.modelInfo.PrimaryKey, ids).Get(ctx)
+}
+
 // FindOrFail finds a record by ID or returns an error.
 // In Go, this is identical to Find, but added for API parity.
 func (m *Model[T]) FindOrFail(ctx context.Context, id any) (*T, error) {
 	return m.Find(ctx, id)
 }
 
+// FirstWhere returns the first record where column equals value, or
+// ErrRecordNotFound. It works on a clone, so the builder can be reused.
+//
+// Example:
+//
+//	user, err := zorm.New[User]().FirstWhere(ctx, "email", "john@example.com")
+func (m *Model[T]) FirstWhere(ctx context.Context, column string, value any) (*T, error) {
+	if err := ValidateColumnName(column); err != nil {
+		return nil, fmt.Errorf("zorm: FirstWhere: invalid column %q: %w", column, err)
+	}
+	return m.Clone().Where(column, value).First(ctx)
+}
+
+// FirstWhereOrFail is FirstWhere, added for API parity with FindOrFail.
+func (m *Model[T]) FirstWhereOrFail(ctx context.Context, column string, value any) (*T, error) {
+	return m.FirstWhere(ctx, column, value)
+}
+
 // Pluck retrieves a single column's values from the result set.
 // Column names are validated to prevent SQL injection.
 // This method is safe for concurrent use - it clones the model before modification.
@@ -226,7 +325,7 @@ func (m *Model[T]) Pluck(ctx context.Context, column string) ([]any, error) {
 
 	query, args := q.buildSelectQuery()
 
-	rows, err := q.queryer().QueryContext(ctx, rebind(query), args...)
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(query), args...)
 	if err != nil {
 		return nil, WrapQueryError("SELECT", query, args, err)
 	}
@@ -254,6 +353,109 @@ func (m *Model[T]) Pluck(ctx context.Context, column string) ([]any, error) {
 	return results, nil
 }
 
+// PluckTyped is Pluck with each value scanned into V, so callers get a
+// []string or []int64 instead of []any. V can be any type sql.Rows.Scan
+// supports; use a sql.Null* type (or sql.Null[V]) when the column may hold
+// NULLs, since scanning NULL into a plain type fails.
+//
+// Example:
+//
+//	emails, err := zorm.PluckTyped[User, string](ctx, zorm.New[User]().Where("active", true), "email")
+func PluckTyped[T any, V any](ctx context.Context, m *Model[T], column string) ([]V, error) {
+	if m.buildErr != nil {
+		return nil, m.buildErr
+	}
+	if err := ValidateColumnName(column); err != nil {
+		return nil, err
+	}
+
+	// Clone to avoid mutating shared state (thread-safe)
+	q := m.Clone()
+	q.columns = []string{column}
+
+	query, args := q.buildSelectQuery()
+
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("SELECT", query, args, err)
+	}
+	defer rows.Close()
+
+	initialCap := q.limit
+	if initialCap <= 0 {
+		initialCap = 64 // Default capacity for unbounded queries
+	}
+	results := make([]V, 0, initialCap)
+
+	for rows.Next() {
+		var val V
+		if err := rows.Scan(&val); err != nil {
+			return nil, WrapQueryError("SCAN", query, args, err)
+		}
+		results = append(results, val)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, WrapQueryError("SCAN", query, args, err)
+	}
+
+	return results, nil
+}
+
+// PluckMap retrieves keyColumn and valueColumn and returns them as a map,
+// like Laravel's pluck('value', 'key'). When several rows share a key, later
+// rows overwrite earlier ones, so add an OrderBy if that matters. []byte
+// values are converted to string so text keys stay hashable; NULL values are
+// stored as nil.
+//
+// Example:
+//
+//	names, err := zorm.New[User]().Where("active", true).PluckMap(ctx, "id", "name")
+func (m *Model[T]) PluckMap(ctx context.Context, keyColumn, valueColumn string) (map[any]any, error) {
+	result := make(map[any]any)
+	if m.buildErr != nil {
+		return result, m.buildErr
+	}
+	if err := ValidateColumnName(keyColumn); err != nil {
+		return result, err
+	}
+	if err := ValidateColumnName(valueColumn); err != nil {
+		return result, err
+	}
+
+	// Clone to avoid mutating shared state (thread-safe)
+	q := m.Clone()
+	q.columns = []string{keyColumn, valueColumn}
+
+	query, args := q.buildSelectQuery()
+
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("SELECT", query, args, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key, val any
+		if err := rows.Scan(&key, &val); err != nil {
+			return nil, WrapQueryError("SCAN", query, args, err)
+		}
+		if b, ok := key.([]byte); ok {
+			key = string(b)
+		}
+		if b, ok := val.([]byte); ok {
+			val = string(b)
+		}
+		result[key] = val
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, WrapQueryError("SCAN", query, args, err)
+	}
+
+	return result, nil
+}
+
 // Count returns the number of records matching the query.
 // This method is safe for concurrent use - it clones the model before modification.
 // When the query includes GROUP BY, DISTINCT, or DISTINCT ON, the count is wrapped
@@ -276,6 +478,7 @@ func (m *Model[T]) Count(ctx context.Context) (int64, error) {
 	// query in a subquery to get the correct total row count.
 	needsSubquery := len(q.groupBys) > 0 || q.distinct || len(q.distinctOn) > 0
 
+	var selectArgs, joinArgs, havingArgs []any
 	if needsSubquery {
 		sb.WriteString("SELECT COUNT(*) FROM (SELECT ")
 
@@ -287,13 +490,24 @@ func (m *Model[T]) Count(ctx context.Context) (int64, error) {
 			sb.WriteString("DISTINCT ")
 		}
 
-		if q.distinct && len(q.columns) > 0 {
+		// Keep the original select list so DISTINCT sees the selected values
+		// and HAVING can refer to select aliases.
+		switch {
+		case len(q.columns) > 0:
 			sb.WriteString(strings.Join(q.columns, ", "))
-		} else {
+		case q.distinct:
+			sb.WriteString("*")
+		default:
 			sb.WriteString("1")
 		}
+		for _, expr := range q.selectExprs {
+			sb.WriteString(", ")
+			sb.WriteString(expr)
+		}
+		selectArgs = q.selectArgs
 		sb.WriteString(" FROM ")
 		sb.WriteString(tableName)
+		joinArgs = q.buildJoinClauses(&sb)
 		q.buildWhereClause(&sb)
 
 		if len(q.groupBys) > 0 {
@@ -301,20 +515,18 @@ func (m *Model[T]) Count(ctx context.Context) (int64, error) {
 			sb.WriteString(strings.Join(q.groupBys, ", "))
 		}
 
-		if len(q.havings) > 0 {
-			sb.WriteString(" HAVING ")
-			sb.WriteString(strings.Join(q.havings, " AND "))
-		}
+		havingArgs = q.buildHavingClause(&sb)
 
 		sb.WriteString(") AS _count_subquery")
 	} else {
 		sb.WriteString("SELECT COUNT(*) FROM ")
 		sb.WriteString(tableName)
+		joinArgs = q.buildJoinClauses(&sb)
 		q.buildWhereClause(&sb)
 	}
 
 	query := sb.String()
-	args := append(cteArgs, q.args...)
+	args := append(append(append(append(cteArgs, selectArgs...), joinArgs...), q.args...), havingArgs...)
 
 	var count int64
 	var err error
@@ -323,7 +535,7 @@ func (m *Model[T]) Count(ctx context.Context) (int64, error) {
 	if q.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = q.prepareStmt(ctx, rebind(query))
+		stmt, release, err = q.prepareStmt(ctx, q.rebind(query))
 		if err != nil {
 			return 0, WrapQueryError("PREPARE", query, args, err)
 		}
@@ -331,7 +543,7 @@ func (m *Model[T]) Count(ctx context.Context) (int64, error) {
 
 		err = stmt.QueryRowContext(ctx, args...).Scan(&count)
 	} else {
-		err = q.queryer().QueryRowContext(ctx, rebind(query), args...).Scan(&count)
+		err = q.queryer().QueryRowContext(ctx, q.rebind(query), args...).Scan(&count)
 	}
 
 	if err != nil {
@@ -342,9 +554,13 @@ func (m *Model[T]) Count(ctx context.Context) (int64, error) {
 }
 
 // Exists checks if any record matches the query conditions.
-// It uses "SELECT 1 FROM table WHERE conditions LIMIT 1" for efficiency.
+// It uses "SELECT EXISTS(SELECT 1 FROM table WHERE conditions LIMIT 1)" so the
+// database can stop at the first match instead of counting every row.
 // This method is safe for concurrent use - it clones the model before modification.
 func (m *Model[T]) Exists(ctx context.Context) (bool, error) {
+	if m.buildErr != nil {
+		return false, m.buildErr
+	}
 	// Clone to avoid mutating shared state (thread-safe)
 	q := m.Clone()
 	q.limit = 1
@@ -355,23 +571,24 @@ func (m *Model[T]) Exists(ctx context.Context) (bool, error) {
 	var sb strings.Builder
 	cteArgs := q.buildWithClause(&sb)
 
-	sb.WriteString("SELECT 1 FROM ")
+	sb.WriteString("SELECT EXISTS(SELECT 1 FROM ")
 	sb.WriteString(tableName)
 
+	joinArgs := q.buildJoinClauses(&sb)
 	q.buildWhereClause(&sb)
-	sb.WriteString(" LIMIT 1")
+	sb.WriteString(" LIMIT 1)")
 
 	query := sb.String()
-	args := append(cteArgs, q.args...)
+	args := append(append(cteArgs, joinArgs...), q.args...)
 
-	var exists int
+	var exists bool
 	var err error
 
 	// Use prepared statement if caching is enabled
 	if q.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = q.prepareStmt(ctx, rebind(query))
+		stmt, release, err = q.prepareStmt(ctx, q.rebind(query))
 		if err != nil {
 			return false, WrapQueryError("PREPARE", query, args, err)
 		}
@@ -379,82 +596,151 @@ func (m *Model[T]) Exists(ctx context.Context) (bool, error) {
 
 		err = stmt.QueryRowContext(ctx, args...).Scan(&exists)
 	} else {
-		err = q.queryer().QueryRowContext(ctx, rebind(query), args...).Scan(&exists)
+		err = q.queryer().QueryRowContext(ctx, q.rebind(query), args...).Scan(&exists)
 	}
 
-	if err == sql.ErrNoRows {
-		return false, nil
-	}
 	if err != nil {
 		return false, WrapQueryError("EXISTS", query, args, err)
 	}
 
-	return true, nil
+	return exists, nil
 }
 
-// Sum calculates the sum of a column.
+// DoesntExist reports whether no record matches the query conditions.
+// It is the negation of Exists.
+func (m *Model[T]) DoesntExist(ctx context.Context) (bool, error) {
+	exists, err := m.Exists(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !exists, nil
+}
+
+// Unique reports whether no row has value in column, for validating input
+// before Create or Update instead of relying on a constraint violation.
+// Pass the id of the row being updated as exceptID to ignore that row.
+// Conditions already on the query (e.g. a tenant scope) still apply.
+//
+// Example:
+//
+//	ok, err := New[User]().Unique(ctx, "email", input.Email, user.ID)
+//	if err == nil && !ok {
+//	    return errors.New("email is already taken")
+//	}
+func (m *Model[T]) Unique(ctx context.Context, column string, value any, exceptID ...any) (bool, error) {
+	if err := ValidateColumnName(column); err != nil {
+		return false, err
+	}
+	q := m.Clone().Where(column, value)
+	if len(exceptID) > 0 {
+		q.Where(m.modelInfo.PrimaryKey, "!=", exceptID[0])
+	}
+	if q.buildErr != nil {
+		return false, q.buildErr
+	}
+	return q.DoesntExist(ctx)
+}
+
+// Sum calculates the sum of a column or of an arithmetic expression over
+// columns, such as "price * qty".
 // Returns 0 if no rows match or the sum is null.
 // Column names are validated to prevent SQL injection.
 // This method is safe for concurrent use - it clones the model before modification.
 func (m *Model[T]) Sum(ctx context.Context, column string) (float64, error) {
-	if err := ValidateColumnName(column); err != nil {
+	var result sql.NullFloat64
+	if err := m.aggregate(ctx, "SUM", column, &result); err != nil {
 		return 0, err
 	}
+	if result.Valid {
+		return result.Float64, nil
+	}
+	return 0, nil
+}
 
-	// Clone to avoid mutating shared state (thread-safe)
-	q := m.Clone()
-	q.limit, q.offset = 0, 0
-	q.orderBys = nil
-
-	tableName := q.TableName()
-	var sb strings.Builder
-	cteArgs := q.buildWithClause(&sb)
-
-	sb.WriteString("SELECT SUM(")
-	sb.WriteString(column)
-	sb.WriteString(") FROM ")
-	sb.WriteString(tableName)
-
-	q.buildWhereClause(&sb)
-
-	query := sb.String()
-	args := append(cteArgs, q.args...)
+// Avg calculates the average of a column or of an arithmetic expression
+// over columns, as in Sum.
+// Returns 0 if no rows match or the average is null.
+// Column names are validated to prevent SQL injection.
+// This method is safe for concurrent use - it clones the model before modification.
+func (m *Model[T]) Avg(ctx context.Context, column string) (float64, error) {
+	var result sql.NullFloat64
+	if err := m.aggregate(ctx, "AVG", column, &result); err != nil {
+		return 0, err
+	}
+	if result.Valid {
+		return result.Float64, nil
+	}
+	return 0, nil
+}
 
+// Min returns the smallest value of a numeric column.
+// The result is not Valid if no rows match or every value is NULL.
+// Column names are validated to prevent SQL injection.
+// This method is safe for concurrent use - it clones the model before modification.
+func (m *Model[T]) Min(ctx context.Context, column string) (sql.NullFloat64, error) {
 	var result sql.NullFloat64
-	var err error
+	err := m.aggregate(ctx, "MIN", column, &result)
+	return result, err
+}
 
-	// Use prepared statement if caching is enabled
-	if q.stmtCache != nil {
-		var stmt *sql.Stmt
-		var release func()
-		stmt, release, err = q.prepareStmt(ctx, rebind(query))
-		if err != nil {
-			return 0, WrapQueryError("PREPARE", query, args, err)
-		}
-		defer release()
+// Max returns the largest value of a numeric column.
+// The result is not Valid if no rows match or every value is NULL.
+// Column names are validated to prevent SQL injection.
+// This method is safe for concurrent use - it clones the model before modification.
+func (m *Model[T]) Max(ctx context.Context, column string) (sql.NullFloat64, error) {
+	var result sql.NullFloat64
+	err := m.aggregate(ctx, "MAX", column, &result)
+	return result, err
+}
 
-		err = stmt.QueryRowContext(ctx, args...).Scan(&result)
-	} else {
-		err = q.queryer().QueryRowContext(ctx, rebind(query), args...).Scan(&result)
+// MinValue returns the smallest value of a column of any type, such as a
+// date or string column, as returned by the driver ([]byte is converted to
+// string). It returns nil if no rows match or every value is NULL.
+func (m *Model[T]) MinValue(ctx context.Context, column string) (any, error) {
+	var result any
+	if err := m.aggregate(ctx, "MIN", column, &result); err != nil {
+		return nil, err
 	}
+	if b, ok := result.([]byte); ok {
+		return string(b), nil
+	}
+	return result, nil
+}
 
-	if err != nil {
-		return 0, WrapQueryError("SUM", query, args, err)
+// MaxValue returns the largest value of a column of any type, such as a
+// date or string column, as returned by the driver ([]byte is converted to
+// string). It returns nil if no rows match or every value is NULL.
+func (m *Model[T]) MaxValue(ctx context.Context, column string) (any, error) {
+	var result any
+	if err := m.aggregate(ctx, "MAX", column, &result); err != nil {
+		return nil, err
+	}
+	if b, ok := result.([]byte); ok {
+		return string(b), nil
 	}
+	return result, nil
+}
 
-	if result.Valid {
-		return result.Float64, nil
+// validateAggregateColumn accepts the argument of SUM, AVG, MIN and MAX: a
+// column name, or an arithmetic expression over columns and numbers such as
+// "price * qty" or "(total - discount) / 100".
+func validateAggregateColumn(column string) error {
+	err := ValidateColumnName(column)
+	if err != nil && validateArithmeticExpr(column) == nil {
+		return nil
 	}
-	return 0, nil
+	return err
 }
 
-// Avg calculates the average of a column.
-// Returns 0 if no rows match or the average is null.
-// Column names are validated to prevent SQL injection.
-// This method is safe for concurrent use - it clones the model before modification.
-func (m *Model[T]) Avg(ctx context.Context, column string) (float64, error) {
-	if err := ValidateColumnName(column); err != nil {
-		return 0, err
+// aggregate runs `SELECT fn(column)` over the current WHERE clause and CTEs
+// and scans the single result into dest. LIMIT, OFFSET and ORDER BY are
+// dropped. It backs Sum, Avg, Min, Max, MinValue and MaxValue.
+func (m *Model[T]) aggregate(ctx context.Context, fn, column string, dest any) error {
+	if m.buildErr != nil {
+		return m.buildErr
+	}
+	if err := validateAggregateColumn(column); err != nil {
+		return fmt.Errorf("zorm: %s: invalid column %q: %w", fn, column, err)
 	}
 
 	// Clone to avoid mutating shared state (thread-safe)
@@ -466,7 +752,9 @@ func (m *Model[T]) Avg(ctx context.Context, column string) (float64, error) {
 	var sb strings.Builder
 	cteArgs := q.buildWithClause(&sb)
 
-	sb.WriteString("SELECT AVG(")
+	sb.WriteString("SELECT ")
+	sb.WriteString(fn)
+	sb.WriteString("(")
 	sb.WriteString(column)
 	sb.WriteString(") FROM ")
 	sb.WriteString(tableName)
@@ -476,32 +764,27 @@ func (m *Model[T]) Avg(ctx context.Context, column string) (float64, error) {
 	query := sb.String()
 	args := append(cteArgs, q.args...)
 
-	var result sql.NullFloat64
 	var err error
 
 	// Use prepared statement if caching is enabled
 	if q.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = q.prepareStmt(ctx, rebind(query))
+		stmt, release, err = q.prepareStmt(ctx, q.rebind(query))
 		if err != nil {
-			return 0, WrapQueryError("PREPARE", query, args, err)
+			return WrapQueryError("PREPARE", query, args, err)
 		}
 		defer release()
 
-		err = stmt.QueryRowContext(ctx, args...).Scan(&result)
+		err = stmt.QueryRowContext(ctx, args...).Scan(dest)
 	} else {
-		err = q.queryer().QueryRowContext(ctx, rebind(query), args...).Scan(&result)
+		err = q.queryer().QueryRowContext(ctx, q.rebind(query), args...).Scan(dest)
 	}
 
 	if err != nil {
-		return 0, WrapQueryError("AVG", query, args, err)
+		return WrapQueryError(fn, query, args, err)
 	}
-
-	if result.Valid {
-		return result.Float64, nil
-	}
-	return 0, nil
+	return nil
 }
 
 // CountOver returns count of records partitioned by the specified column.
@@ -510,8 +793,11 @@ func (m *Model[T]) Avg(ctx context.Context, column string) (float64, error) {
 // Column names are validated to prevent SQL injection.
 // This method is safe for concurrent use - it clones the model before modification.
 func (m *Model[T]) CountOver(ctx context.Context, column string) (map[any]int64, error) {
+	if m.buildErr != nil {
+		return nil, m.buildErr
+	}
 	if err := ValidateColumnName(column); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("zorm: CountOver: invalid column %q: %w", column, err)
 	}
 
 	// Clone to avoid mutating shared state (thread-safe, consistent with Count/Sum/Avg)
@@ -529,7 +815,7 @@ func (m *Model[T]) CountOver(ctx context.Context, column string) (map[any]int64,
 	// Add WHERE clause
 	q.buildWhereClause(&sb)
 
-	rows, err := q.queryer().QueryContext(ctx, rebind(sb.String()), q.args...)
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(sb.String()), q.args...)
 	if err != nil {
 		return nil, err
 	}
@@ -548,6 +834,170 @@ func (m *Model[T]) CountOver(ctx context.Context, column string) (map[any]int64,
 	return result, rows.Err()
 }
 
+// ValueCounts returns how many matching rows hold each distinct value of
+// column, for building filter facets such as counts per category. It runs
+// `SELECT column, COUNT(*) ... GROUP BY column` over the current WHERE clause;
+// NULL values are counted under a nil key and []byte keys become strings.
+// Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	counts, err := zorm.New[Product]().Where("active", true).ValueCounts(ctx, "category")
+//	// SELECT category, COUNT(*) FROM products WHERE 1=1 AND active = ? GROUP BY category
+func (m *Model[T]) ValueCounts(ctx context.Context, column string) (map[any]int64, error) {
+	if m.buildErr != nil {
+		return nil, m.buildErr
+	}
+	if err := ValidateColumnName(column); err != nil {
+		return nil, err
+	}
+
+	// Clone to avoid mutating shared state (thread-safe)
+	q := m.Clone()
+
+	var sb strings.Builder
+	cteArgs := q.buildWithClause(&sb)
+
+	sb.WriteString("SELECT ")
+	sb.WriteString(column)
+	sb.WriteString(", COUNT(*) FROM ")
+	sb.WriteString(q.TableName())
+	q.buildWhereClause(&sb)
+	sb.WriteString(" GROUP BY ")
+	sb.WriteString(column)
+
+	query := sb.String()
+	args := append(cteArgs, q.args...)
+
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("SELECT", query, args, err)
+	}
+	defer rows.Close()
+
+	result := make(map[any]int64)
+	for rows.Next() {
+		var key any
+		var count int64
+		if err := rows.Scan(&key, &count); err != nil {
+			return nil, WrapQueryError("SCAN", query, args, err)
+		}
+		if b, ok := key.([]byte); ok {
+			key = string(b)
+		}
+		result[key] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, WrapQueryError("SCAN", query, args, err)
+	}
+
+	return result, nil
+}
+
+// validAggregateFuncs is the whitelist of aggregate functions accepted by
+// AggregateOver.
+var validAggregateFuncs = map[string]bool{
+	"SUM":   true,
+	"AVG":   true,
+	"MIN":   true,
+	"MAX":   true,
+	"COUNT": true,
+}
+
+// AggregateOver groups the rows matched by m on groupColumn and returns the
+// aggregate aggExpr for each group, keyed by the group value scanned as K.
+// It generalizes CountOver to any aggregate: aggExpr must be one of SUM, AVG,
+// MIN, MAX or COUNT applied to a single column (optionally DISTINCT), or
+// COUNT(*). Rows whose group value is NULL are skipped, and a NULL aggregate
+// is reported as 0.
+//
+// Example:
+//
+//	totals, err := zorm.AggregateOver[int64](ctx, zorm.New[Order]().Where("status", "paid"), "customer_id", "SUM(amount)")
+//	// SELECT customer_id, SUM(amount) FROM orders WHERE status = $1 GROUP BY customer_id
+func AggregateOver[K comparable, T any](ctx context.Context, m *Model[T], groupColumn, aggExpr string) (map[K]float64, error) {
+	if m.buildErr != nil {
+		return nil, m.buildErr
+	}
+	if err := ValidateColumnName(groupColumn); err != nil {
+		return nil, err
+	}
+	agg, err := normalizeAggregateExpr(aggExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	q := m.Clone()
+
+	var sb strings.Builder
+	cteArgs := q.buildWithClause(&sb)
+	sb.WriteString("SELECT ")
+	sb.WriteString(groupColumn)
+	sb.WriteString(", ")
+	sb.WriteString(agg)
+	sb.WriteString(" FROM ")
+	sb.WriteString(q.TableName())
+	q.buildWhereClause(&sb)
+	sb.WriteString(" GROUP BY ")
+	sb.WriteString(groupColumn)
+
+	args := append(cteArgs, q.args...)
+	query := sb.String()
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("SELECT", query, args, err)
+	}
+	defer rows.Close()
+
+	result := make(map[K]float64)
+	for rows.Next() {
+		var key sql.Null[K]
+		var value sql.NullFloat64
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		if key.Valid {
+			result[key.V] = value.Float64
+		}
+	}
+	return result, rows.Err()
+}
+
+// normalizeAggregateExpr validates an AggregateOver expression such as
+// "sum(amount)" or "COUNT(DISTINCT user_id)" and returns it with the function
+// name upper-cased.
+func normalizeAggregateExpr(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	open := strings.IndexByte(expr, '(')
+	if open <= 0 || !strings.HasSuffix(expr, ")") {
+		return "", fmt.Errorf("zorm: AggregateOver: invalid aggregate %q; use FUNC(column)", expr)
+	}
+	fn := strings.ToUpper(strings.TrimSpace(expr[:open]))
+	if !validAggregateFuncs[fn] {
+		return "", fmt.Errorf("zorm: AggregateOver: unsupported aggregate function %q", fn)
+	}
+	inner := strings.TrimSpace(expr[open+1 : len(expr)-1])
+	if inner == "*" {
+		if fn != "COUNT" {
+			return "", fmt.Errorf("zorm: AggregateOver: %s(*) is not allowed", fn)
+		}
+		return "COUNT(*)", nil
+	}
+	prefix := ""
+	if upper := strings.ToUpper(inner); strings.HasPrefix(upper, "DISTINCT ") {
+		prefix = "DISTINCT "
+		inner = strings.TrimSpace(inner[len("DISTINCT "):])
+	}
+	if strings.ContainsAny(inner, "(),* ") {
+		return "", fmt.Errorf("zorm: AggregateOver: invalid aggregate column %q", inner)
+	}
+	if err := ValidateColumnName(inner); err != nil {
+		return "", err
+	}
+	return fn + "(" + prefix + inner + ")", nil
+}
+
 // buildSelectQuery constructs the SQL SELECT statement from the query builder state.
 // It handles SELECT with DISTINCT, DISTINCT ON (PostgreSQL), columns, WHERE, GROUP BY,
 // HAVING, ORDER BY, LIMIT, and OFFSET clauses.
@@ -556,7 +1006,21 @@ func (m *Model[T]) buildSelectQuery() (string, []any) {
 	if m.rawQuery != "" {
 		return m.rawQuery, m.rawArgs
 	}
+	if c := m.cachedSelect(); c != nil {
+		// Cap the args so an append by the caller cannot write into the cache.
+		return c.query, c.args[:len(c.args):len(c.args)]
+	}
 
+	query, args := m.renderSelectQuery()
+	if m.cacheableSelect() {
+		m.selectCache.Store(m.newSelectQueryCache(query, args))
+	}
+	return query, args[:len(args):len(args)]
+}
+
+// renderSelectQuery generates the SELECT statement and its args from the
+// builder state. buildSelectQuery memoizes its output.
+func (m *Model[T]) renderSelectQuery() (string, []any) {
 	sb := GetStringBuilder()
 	defer PutStringBuilder(sb)
 
@@ -578,25 +1042,16 @@ func (m *Model[T]) buildSelectQuery() (string, []any) {
 	} else {
 		sb.WriteString("*")
 	}
+	for _, expr := range m.selectExprs {
+		sb.WriteString(", ")
+		sb.WriteString(expr)
+	}
 
 	sb.WriteString(" FROM ")
 	sb.WriteString(m.TableName())
 
 	// Emit JOIN clauses (before WHERE)
-	for _, j := range m.joins {
-		sb.WriteByte(' ')
-		sb.WriteString(j.joinType)
-		sb.WriteByte(' ')
-		sb.WriteString(j.table)
-		if j.col1 != "" {
-			sb.WriteString(" ON ")
-			sb.WriteString(j.col1)
-			sb.WriteByte(' ')
-			sb.WriteString(j.op)
-			sb.WriteByte(' ')
-			sb.WriteString(j.col2)
-		}
-	}
+	joinArgs := m.buildJoinClauses(sb)
 
 	m.buildWhereClause(sb)
 
@@ -605,14 +1060,15 @@ func (m *Model[T]) buildSelectQuery() (string, []any) {
 		sb.WriteString(strings.Join(m.groupBys, ", "))
 	}
 
-	if len(m.havings) > 0 {
-		sb.WriteString(" HAVING ")
-		sb.WriteString(strings.Join(m.havings, " AND "))
-	}
+	havingArgs := m.buildHavingClause(sb)
 
 	if len(m.orderBys) > 0 {
 		sb.WriteString(" ORDER BY ")
 		sb.WriteString(strings.Join(m.orderBys, ", "))
+		if tiebreaker := m.orderTiebreaker(); tiebreaker != "" {
+			sb.WriteString(", ")
+			sb.WriteString(tiebreaker)
+		}
 	}
 
 	if m.lockMode != "" {
@@ -630,14 +1086,65 @@ func (m *Model[T]) buildSelectQuery() (string, []any) {
 		sb.WriteString(strconv.Itoa(m.offset))
 	}
 
-	// Pre-allocate args slice with correct capacity
-	allArgs := make([]any, 0, len(cteArgs)+len(m.args))
+	// Pre-allocate args slice with correct capacity. SELECT-list and JOIN ON
+	// args sit between the CTE args and the WHERE args, matching placeholder order.
+	allArgs := make([]any, 0, len(cteArgs)+len(m.selectArgs)+len(joinArgs)+len(m.args)+len(m.havingArgs)+len(m.orderArgs))
 	allArgs = append(allArgs, cteArgs...)
+	allArgs = append(allArgs, m.selectArgs...)
+	allArgs = append(allArgs, joinArgs...)
 	allArgs = append(allArgs, m.args...)
+	allArgs = append(allArgs, havingArgs...)
+	if len(m.orderBys) > 0 {
+		allArgs = append(allArgs, m.orderArgs...)
+	}
 
 	return sb.String(), allArgs
 }
 
+// buildJoinClauses writes the JOIN clauses to sb and returns the args bound
+// by their ON expressions, in placeholder order.
+func (m *Model[T]) buildJoinClauses(sb *strings.Builder) []any {
+	var args []any
+	for _, j := range m.joins {
+		sb.WriteByte(' ')
+		sb.WriteString(j.joinType)
+		sb.WriteByte(' ')
+		sb.WriteString(j.table)
+		if j.on != "" {
+			sb.WriteString(" ON ")
+			sb.WriteString(j.on)
+		} else if j.col1 != "" {
+			sb.WriteString(" ON ")
+			sb.WriteString(j.col1)
+			sb.WriteByte(' ')
+			sb.WriteString(j.op)
+			sb.WriteByte(' ')
+			sb.WriteString(j.col2)
+		}
+		args = append(args, j.args...)
+	}
+	return args
+}
+
+// orderTiebreaker returns the primary key ORDER BY term StableOrder appends,
+// or "" when it does not apply.
+func (m *Model[T]) orderTiebreaker() string {
+	if !m.stableOrder || m.modelInfo == nil || m.modelInfo.PrimaryKey == "" {
+		return ""
+	}
+	if len(m.groupBys) > 0 || m.distinct {
+		return ""
+	}
+	pk := m.modelInfo.PrimaryKey
+	if last := m.orderBys[len(m.orderBys)-1]; last == pk || strings.HasPrefix(last, pk+" ") {
+		return ""
+	}
+	if len(m.joins) > 0 {
+		pk = m.TableName() + "." + pk
+	}
+	return pk + " ASC"
+}
+
 // buildWithClause constructs the WITH clause for CTEs.
 func (m *Model[T]) buildWithClause(sb *strings.Builder) []any {
 	if len(m.ctes) == 0 {
@@ -682,6 +1189,25 @@ func (m *Model[T]) buildWhereClause(sb *strings.Builder) {
 	}
 }
 
+// buildHavingClause writes the HAVING clause to sb and returns the args it
+// binds. Each condition carries its connector like wheres; the first one's
+// is dropped.
+func (m *Model[T]) buildHavingClause(sb *strings.Builder) []any {
+	if len(m.havings) == 0 {
+		return nil
+	}
+	sb.WriteString(" HAVING ")
+	for i, h := range m.havings {
+		if i == 0 {
+			_, h, _ = strings.Cut(h, " ")
+		} else {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(h)
+	}
+	return m.havingArgs
+}
+
 // columnMappingCache caches column-to-field mappings per query signature.
 // Key format: "typeName:col1,col2,col3"
 // Note: We use type name (not table name) because different Go types can map to the same table
@@ -801,11 +1327,50 @@ func (m *Model[T]) mapColumns(columns []string) []*FieldInfo {
 	return fields
 }
 
+// scanFields returns the field each result column scans into: by position
+// when ScanPositional is set, by column name otherwise.
+func (m *Model[T]) scanFields(columns []string) ([]*FieldInfo, error) {
+	if !m.scanPositional {
+		return m.mapColumns(columns), nil
+	}
+	if len(columns) > len(m.modelInfo.FieldOrder) {
+		return nil, fmt.Errorf("zorm: ScanPositional: query returned %d columns but %s has %d fields",
+			len(columns), m.modelInfo.Type.Name(), len(m.modelInfo.FieldOrder))
+	}
+	return m.modelInfo.FieldOrder[:len(columns)], nil
+}
+
+// encodeField converts the value of field into a query argument: JSON and
+// array fields are encoded for storage, nil pointers become NULL (without
+// calling a pointer-receiver Value on nil), driver.Valuer implementations
+// are passed through (by pointer when only *T implements it), times are
+// formatted with layout and bools are encoded for the dialect.
+func encodeField(field *FieldInfo, v reflect.Value, layout string, d Dialect) any {
+	switch {
+	case field.IsJSON:
+		return jsonValue{v: v}
+	case field.IsArray:
+		return arrayValue{v: v, dialect: d}
+	case v.Kind() == reflect.Pointer && v.IsNil():
+		return nil
+	case field.addrValuer && v.CanAddr():
+		return v.Addr().Interface()
+	}
+	return encodeBool(encodeTime(v.Interface(), layout), d)
+}
+
 // fillScanDestinations creates scan destinations for sql.Rows.Scan based on pre-calculated field mapping.
-// It reuses the dest slice to avoid allocations per row.
-func (m *Model[T]) fillScanDestinations(fields []*FieldInfo, val reflect.Value, dest []any) {
+// It reuses the dest slice to avoid allocations per row. A non-empty timeLayout
+// routes time fields through timeScanner so text timestamps parse (SetTimeLayout).
+func (m *Model[T]) fillScanDestinations(fields []*FieldInfo, val reflect.Value, dest []any, timeLayout string) {
 	for i, f := range fields {
-		if f != nil {
+		if f != nil && f.IsJSON {
+			dest[i] = &jsonScanner{dst: val.FieldByIndex(f.Index)}
+		} else if f != nil && f.IsArray {
+			dest[i] = &arrayScanner{dst: val.FieldByIndex(f.Index)}
+		} else if f != nil && timeLayout != "" && isTimeField(f.FieldType) {
+			dest[i] = &timeScanner{dst: val.FieldByIndex(f.Index), layout: timeLayout}
+		} else if f != nil {
 			dest[i] = val.FieldByIndex(f.Index).Addr().Interface()
 		} else {
 			var ignore any
@@ -832,8 +1397,12 @@ func (m *Model[T]) scanRows(rows *sql.Rows) ([]*T, error) {
 	results := make([]*T, 0, initialCap)
 
 	// Prepare mapping and destination slice once
-	fields := m.mapColumns(columns)
+	fields, err := m.scanFields(columns)
+	if err != nil {
+		return nil, err
+	}
 	dest := make([]any, len(columns))
+	layout := m.timeLayout()
 
 	for rows.Next() {
 		// Create new instance of T
@@ -841,7 +1410,7 @@ func (m *Model[T]) scanRows(rows *sql.Rows) ([]*T, error) {
 		val := reflect.ValueOf(entity).Elem()
 
 		// Fill scan destinations
-		m.fillScanDestinations(fields, val, dest)
+		m.fillScanDestinations(fields, val, dest, layout)
 
 		if err := rows.Scan(dest...); err != nil {
 			return nil, err
@@ -869,16 +1438,19 @@ func (m *Model[T]) scanRows(rows *sql.Rows) ([]*T, error) {
 // Cursor returns a cursor for iterating over results one by one.
 // Useful for large datasets to avoid loading everything into memory.
 //
-// Relation loading (With, WithCallback, WithMorph) is not supported with Cursor
-// because it requires all rows to be available for batch loading. Use Get() instead
-// when relation loading is needed.
+// Relations requested with With, WithCallback or WithMorph (and WithCount,
+// WithSum and WithAvg) are loaded by Scan for every row, which costs one
+// query per relation per row. Use ScanBatch to load them for a window of
+// rows at once. Relation queries run while the cursor's rows are still
+// open, so they need a second connection from the pool; inside a
+// transaction that is not possible and Cursor returns an error; use Get or
+// Chunk instead.
 func (m *Model[T]) Cursor(ctx context.Context) (*Cursor[T], error) {
-	if len(m.relations) > 0 || len(m.relationCallbacks) > 0 || len(m.morphRelations) > 0 {
-		return nil, fmt.Errorf("zorm: Cursor does not support eager relation loading (With/WithCallback/WithMorph); use Get() instead")
+	if m.tx != nil && m.hasEagerLoads() {
+		return nil, fmt.Errorf("zorm: Cursor cannot eager load relations inside a transaction (With/WithCallback/WithMorph/WithCount/WithSum/WithAvg); use Get() or Chunk() instead")
 	}
-
 	query, args := m.buildSelectQuery()
-	rows, err := m.queryer().QueryContext(ctx, rebind(query), args...)
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -889,6 +1461,13 @@ func (m *Model[T]) Cursor(ctx context.Context) (*Cursor[T], error) {
 	}, nil
 }
 
+// hasEagerLoads reports whether the query loads relations, relation counts
+// or relation aggregates after fetching its rows.
+func (m *Model[T]) hasEagerLoads() bool {
+	return len(m.relations) > 0 || len(m.relationCallbacks) > 0 || len(m.morphRelations) > 0 ||
+		len(m.relationCounts) > 0 || len(m.relationAggs) > 0
+}
+
 // Cursor provides a typed, forward-only iterator over database query results.
 // It wraps sql.Rows and maps each row into the generic model type T.
 type Cursor[T any] struct {
@@ -897,6 +1476,7 @@ type Cursor[T any] struct {
 	columns []string     // Cached column names
 	fields  []*FieldInfo // Cached field mapping
 	dest    []any        // Cached scan destination slice
+	layout  string       // Cached text time layout (SetTimeLayout)
 }
 
 // Next prepares the next result row for reading with the Scan method.
@@ -904,10 +1484,66 @@ func (c *Cursor[T]) Next() bool {
 	return c.rows.Next()
 }
 
-// Scan scans the current row into a new entity.
+// Scan scans the current row into a new entity and loads its relations
+// using ctx.
 // Automatically tracks original values for dirty checking.
 // If a tracking scope is configured, entities are registered with the scope.
 func (c *Cursor[T]) Scan(ctx context.Context) (*T, error) {
+	entity, err := c.scanRow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.loadRelations(ctx, []*T{entity}); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// ScanBatch advances the cursor over up to size rows and returns them, with
+// their relations loaded together: one query per relation for the whole
+// batch instead of one per row. It returns an empty slice once the rows are
+// exhausted; check Err afterwards. Do not call Next before ScanBatch.
+//
+// Example:
+//
+//	cursor, _ := New[User]().With("Posts").Cursor(ctx)
+//	defer cursor.Close()
+//	for {
+//	    users, err := cursor.ScanBatch(ctx, 500)
+//	    if err != nil || len(users) == 0 {
+//	        break
+//	    }
+//	    process(users)
+//	}
+func (c *Cursor[T]) ScanBatch(ctx context.Context, size int) ([]*T, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("zorm: ScanBatch: size must be at least 1, got %d", size)
+	}
+	batch := make([]*T, 0, size)
+	for len(batch) < size && c.rows.Next() {
+		entity, err := c.scanRow(ctx)
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, entity)
+	}
+	if err := c.rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := c.loadRelations(ctx, batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (c *Cursor[T]) Err() error {
+	return c.rows.Err()
+}
+
+// scanRow scans the current row into a new entity without loading its
+// relations.
+func (c *Cursor[T]) scanRow(ctx context.Context) (*T, error) {
 	// Cache columns and mapping on first call
 	if c.columns == nil {
 		var err error
@@ -916,15 +1552,19 @@ func (c *Cursor[T]) Scan(ctx context.Context) (*T, error) {
 			return nil, err
 		}
 		// Init cache
-		c.fields = c.model.mapColumns(c.columns)
+		c.fields, err = c.model.scanFields(c.columns)
+		if err != nil {
+			return nil, err
+		}
 		c.dest = make([]any, len(c.columns))
+		c.layout = c.model.timeLayout()
 	}
 
 	entity := new(T)
 	val := reflect.ValueOf(entity).Elem()
 
 	// Use helper to fill destinations
-	c.model.fillScanDestinations(c.fields, val, c.dest)
+	c.model.fillScanDestinations(c.fields, val, c.dest, c.layout)
 
 	if err := c.rows.Scan(c.dest...); err != nil {
 		return nil, err
@@ -946,6 +1586,18 @@ func (c *Cursor[T]) Scan(ctx context.Context) (*T, error) {
 	return entity, nil
 }
 
+// loadRelations eager loads the cursor's relations, counts and aggregates
+// on entities.
+func (c *Cursor[T]) loadRelations(ctx context.Context, entities []*T) error {
+	if err := c.model.loadRelations(ctx, entities); err != nil {
+		return err
+	}
+	if err := c.model.loadRelationCounts(ctx, entities); err != nil {
+		return err
+	}
+	return c.model.loadRelationAggregates(ctx, entities)
+}
+
 // Close closes the cursor.
 func (c *Cursor[T]) Close() error {
 	return c.rows.Close()
@@ -1180,9 +1832,33 @@ func needsAutoTx[T any](op writeOp, entity *T) bool {
 	return false
 }
 
-// callBeforeCreate dispatches BeforeCreateTx if implemented, else BeforeCreate.
-// Never both.
+// callBeforeSave dispatches BeforeSave, which runs before both the create and
+// update hooks so validation and normalization can live in one place.
+func (m *Model[T]) callBeforeSave(ctx context.Context, entity *T) error {
+	if hook, ok := any(entity).(interface {
+		BeforeSave(context.Context) error
+	}); ok {
+		return hook.BeforeSave(ctx)
+	}
+	return nil
+}
+
+// callAfterSave dispatches AfterSave, which runs after AfterCreate/AfterUpdate.
+func (m *Model[T]) callAfterSave(ctx context.Context, entity *T) error {
+	if hook, ok := any(entity).(interface {
+		AfterSave(context.Context) error
+	}); ok {
+		return hook.AfterSave(ctx)
+	}
+	return nil
+}
+
+// callBeforeCreate dispatches BeforeSave, then BeforeCreateTx if implemented,
+// else BeforeCreate. Never both.
 func (m *Model[T]) callBeforeCreate(ctx context.Context, entity *T) error {
+	if err := m.callBeforeSave(ctx, entity); err != nil {
+		return err
+	}
 	if hook, ok := any(entity).(interface {
 		BeforeCreateTx(context.Context, *Tx) error
 	}); ok {
@@ -1196,23 +1872,31 @@ func (m *Model[T]) callBeforeCreate(ctx context.Context, entity *T) error {
 	return nil
 }
 
-// callAfterCreate dispatches AfterCreateTx if implemented, else AfterCreate.
+// callAfterCreate dispatches AfterCreateTx if implemented, else AfterCreate,
+// then AfterSave.
 func (m *Model[T]) callAfterCreate(ctx context.Context, entity *T) error {
 	if hook, ok := any(entity).(interface {
 		AfterCreateTx(context.Context, *Tx) error
 	}); ok {
-		return hook.AfterCreateTx(ctx, &Tx{Tx: m.tx, ctx: ctx})
-	}
-	if hook, ok := any(entity).(interface {
+		if err := hook.AfterCreateTx(ctx, &Tx{Tx: m.tx, ctx: ctx}); err != nil {
+			return err
+		}
+	} else if hook, ok := any(entity).(interface {
 		AfterCreate(context.Context) error
 	}); ok {
-		return hook.AfterCreate(ctx)
+		if err := hook.AfterCreate(ctx); err != nil {
+			return err
+		}
 	}
-	return nil
+	return m.callAfterSave(ctx, entity)
 }
 
-// callBeforeUpdate dispatches BeforeUpdateTx if implemented, else BeforeUpdate.
+// callBeforeUpdate dispatches BeforeSave, then BeforeUpdateTx if implemented,
+// else BeforeUpdate.
 func (m *Model[T]) callBeforeUpdate(ctx context.Context, entity *T) error {
+	if err := m.callBeforeSave(ctx, entity); err != nil {
+		return err
+	}
 	if hook, ok := any(entity).(interface {
 		BeforeUpdateTx(context.Context, *Tx) error
 	}); ok {
@@ -1226,19 +1910,23 @@ func (m *Model[T]) callBeforeUpdate(ctx context.Context, entity *T) error {
 	return nil
 }
 
-// callAfterUpdate dispatches AfterUpdateTx if implemented, else AfterUpdate.
+// callAfterUpdate dispatches AfterUpdateTx if implemented, else AfterUpdate,
+// then AfterSave.
 func (m *Model[T]) callAfterUpdate(ctx context.Context, entity *T) error {
 	if hook, ok := any(entity).(interface {
 		AfterUpdateTx(context.Context, *Tx) error
 	}); ok {
-		return hook.AfterUpdateTx(ctx, &Tx{Tx: m.tx, ctx: ctx})
-	}
-	if hook, ok := any(entity).(interface {
+		if err := hook.AfterUpdateTx(ctx, &Tx{Tx: m.tx, ctx: ctx}); err != nil {
+			return err
+		}
+	} else if hook, ok := any(entity).(interface {
 		AfterUpdate(context.Context) error
 	}); ok {
-		return hook.AfterUpdate(ctx)
+		if err := hook.AfterUpdate(ctx); err != nil {
+			return err
+		}
 	}
-	return nil
+	return m.callAfterSave(ctx, entity)
 }
 
 // callBeforeDelete dispatches BeforeDeleteTx if implemented, else BeforeDelete.
@@ -1272,19 +1960,118 @@ func (m *Model[T]) callAfterDelete(ctx context.Context, entity *T) error {
 	return nil
 }
 
-// autoSetCreatedAt populates the created_at field with time.Now() when the
-// model declares such a column and the entity's current value is the zero
-// time. Pre-set values are preserved so callers importing historical rows
-// or seeding fixtures retain control.
-func (m *Model[T]) autoSetCreatedAt(entity *T) {
-	fieldInfo, ok := m.modelInfo.Columns["created_at"]
-	if !ok {
-		return
+// autoSetTimestamps populates the created_at and updated_at fields with
+// time.Now() when the model declares such columns and the entity's current
+// value is zero. Pre-set values are preserved so callers importing historical
+// rows or seeding fixtures retain control.
+func (m *Model[T]) autoSetTimestamps(entity *T) {
+	now := time.Now()
+	val := reflect.ValueOf(entity).Elem()
+	for _, col := range [...]string{"created_at", "updated_at"} {
+		fieldInfo, ok := m.modelInfo.Columns[col]
+		if !ok {
+			continue
+		}
+		fieldVal := val.FieldByIndex(fieldInfo.Index)
+		if fieldVal.CanSet() && fieldVal.IsZero() {
+			_ = setFieldValue(fieldVal, now)
+		}
+	}
+}
+
+// autoSetUUIDs fills every zero UUID field (see FieldInfo.IsUUID) with a
+// new random UUID. Pre-set values are preserved.
+func (m *Model[T]) autoSetUUIDs(entity *T) {
+	val := reflect.ValueOf(entity).Elem()
+	for _, field := range m.modelInfo.Fields {
+		if !field.IsUUID {
+			continue
+		}
+		fieldVal := val.FieldByIndex(field.Index)
+		if !fieldVal.CanSet() || !fieldVal.IsZero() {
+			continue
+		}
+		id := uuid.New()
+		switch fieldVal.Kind() {
+		case reflect.String:
+			fieldVal.SetString(id.String())
+		case reflect.Pointer:
+			fieldVal.Set(reflect.ValueOf(&id))
+		default:
+			fieldVal.Set(reflect.ValueOf(id))
+		}
+	}
+}
+
+// Replicate returns a copy of entity ready to be inserted with Create: every
+// mapped column is copied, while the primary key, created_at, updated_at and
+// any columns listed in except are left at their zero values. Pointer, slice
+// and map fields are copied rather than shared, so mutating the replica does
+// not affect entity. Relation fields are not copied. Unknown except columns
+// are ignored.
+//
+// Example:
+//
+//	draft := Model[Post]().Replicate(post, "slug")
+//	draft.Title += " (copy)"
+//	err := Model[Post]().Create(ctx, draft)
+func (m *Model[T]) Replicate(entity *T, except ...string) *T {
+	if entity == nil {
+		return nil
+	}
+	skip := make(map[string]bool, len(except)+3)
+	skip[m.modelInfo.PrimaryKey] = true
+	skip["created_at"] = true
+	skip["updated_at"] = true
+	for _, col := range except {
+		skip[col] = true
+	}
+
+	replica := new(T)
+	src := reflect.ValueOf(entity).Elem()
+	dst := reflect.ValueOf(replica).Elem()
+	for _, field := range m.modelInfo.Fields {
+		if skip[field.Column] {
+			continue
+		}
+		dstField := dst.FieldByIndex(field.Index)
+		if dstField.CanSet() {
+			dstField.Set(copyFieldValue(src.FieldByIndex(field.Index)))
+		}
 	}
-	fieldVal := reflect.ValueOf(entity).Elem().FieldByIndex(fieldInfo.Index)
-	if fieldVal.CanSet() && fieldVal.IsZero() {
-		_ = setFieldValue(fieldVal, time.Now())
+	return replica
+}
+
+// copyFieldValue returns v with its top-level pointer, slice or map storage
+// duplicated so the result does not alias v.
+func copyFieldValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(v.Elem())
+		return p
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(s, v)
+		return s
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		mp := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			mp.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return mp
 	}
+	return v
 }
 
 // Create inserts a new record.
@@ -1304,6 +2091,38 @@ func (m *Model[T]) autoSetCreatedAt(entity *T) {
 //	    return model.WithTx(tx).Create(ctx, entity)
 //	})
 func (m *Model[T]) Create(ctx context.Context, entity *T) error {
+	return m.create(ctx, entity, nil)
+}
+
+// CreateOnly inserts a new record using only the named columns, leaving every
+// other column to its database default. Column names are validated and must
+// map to a field of T. Hooks, created_at handling and primary key hydration
+// behave exactly as in Create.
+//
+// Example:
+//
+//	// status and created_at fall back to their DB defaults
+//	err := zorm.New[Order]().CreateOnly(ctx, order, "user_id", "total")
+func (m *Model[T]) CreateOnly(ctx context.Context, entity *T, columns ...string) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("zorm: %w: CreateOnly requires at least one column", ErrInvalidModel)
+	}
+	only := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		if err := ValidateColumnName(col); err != nil {
+			return fmt.Errorf("zorm: CreateOnly: invalid column %q: %w", col, err)
+		}
+		if _, ok := m.modelInfo.Columns[col]; !ok {
+			return fmt.Errorf("zorm: CreateOnly: %w: %q", ErrColumnNotFound, col)
+		}
+		only[col] = true
+	}
+	return m.create(ctx, entity, only)
+}
+
+// create is the shared implementation for Create and CreateOnly. A nil only
+// inserts every field; otherwise only the listed columns are inserted.
+func (m *Model[T]) create(ctx context.Context, entity *T, only map[string]bool) error {
 	// Validate input
 	if entity == nil {
 		return ErrNilPointer
@@ -1313,13 +2132,14 @@ func (m *Model[T]) Create(ctx context.Context, entity *T) error {
 	// transaction, open one so hook DB work rolls back atomically with the INSERT.
 	if m.tx == nil && needsAutoTx(opCreate, entity) {
 		return m.withAutoTx(ctx, func(txm *Model[T]) error {
-			return txm.Create(ctx, entity)
+			return txm.create(ctx, entity, only)
 		})
 	}
 
-	// Auto-set created_at if it exists and the caller left it zero.
+	// Auto-set created_at/updated_at and UUID keys if the caller left them zero.
 	// Pre-set values are preserved so backfills / fixture imports keep control.
-	m.autoSetCreatedAt(entity)
+	m.autoSetTimestamps(entity)
+	m.autoSetUUIDs(entity)
 
 	// 1. BeforeCreate Hook (prefers BeforeCreateTx when implemented).
 	// If this succeeds but INSERT fails and the plain BeforeCreate is used,
@@ -1335,7 +2155,12 @@ func (m *Model[T]) Create(ctx context.Context, entity *T) error {
 
 	val := reflect.ValueOf(entity).Elem()
 
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
 	for _, field := range m.modelInfo.Fields {
+		if only != nil && !only[field.Column] {
+			continue
+		}
 		fVal := val.FieldByIndex(field.Index)
 		// Skip auto-increment primary key if zero
 		if field.IsPrimary && field.IsAuto {
@@ -1345,7 +2170,7 @@ func (m *Model[T]) Create(ctx context.Context, entity *T) error {
 		}
 
 		columns = append(columns, field.Column)
-		values = append(values, fVal.Interface())
+		values = append(values, encodeField(field, fVal, layout, dialect))
 	}
 
 	sb := GetStringBuilder()
@@ -1355,8 +2180,12 @@ func (m *Model[T]) Create(ctx context.Context, entity *T) error {
 	sb.WriteString(strings.Join(columns, ", "))
 	sb.WriteString(") VALUES (")
 	writePlaceholdersWithSeparator(sb, len(columns), ", ")
-	sb.WriteString(") RETURNING ")
-	sb.WriteString(m.modelInfo.PrimaryKey)
+	sb.WriteByte(')')
+	returning := dialect.SupportsReturning()
+	if returning {
+		sb.WriteString(" RETURNING ")
+		sb.WriteString(m.modelInfo.PrimaryKey)
+	}
 	query := sb.String()
 	PutStringBuilder(sb)
 
@@ -1376,15 +2205,27 @@ func (m *Model[T]) Create(ctx context.Context, entity *T) error {
 	if m.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = m.prepareStmtForWrite(ctx, rebind(query))
+		stmt, release, err = m.prepareStmtForWrite(ctx, m.rebind(query))
 		if err != nil {
 			return WrapQueryError("PREPARE", query, values, err)
 		}
 		defer release()
 
-		err = stmt.QueryRowContext(ctx, values...).Scan(fVal.Addr().Interface())
+		if returning {
+			err = stmt.QueryRowContext(ctx, values...).Scan(fVal.Addr().Interface())
+		} else {
+			var res sql.Result
+			if res, err = stmt.ExecContext(ctx, values...); err == nil {
+				err = setLastInsertID(fVal, res)
+			}
+		}
+	} else if returning {
+		err = m.queryerForWrite().QueryRowContext(ctx, m.rebind(query), values...).Scan(fVal.Addr().Interface())
 	} else {
-		err = m.queryerForWrite().QueryRowContext(ctx, rebind(query), values...).Scan(fVal.Addr().Interface())
+		var res sql.Result
+		if res, err = m.queryerForWrite().ExecContext(ctx, m.rebind(query), values...); err == nil {
+			err = setLastInsertID(fVal, res)
+		}
 	}
 
 	if err != nil {
@@ -1450,6 +2291,8 @@ func (m *Model[T]) Update(ctx context.Context, entity *T) error {
 	values := make([]any, 0, numFields+1) // +1 for PK value
 
 	val := reflect.ValueOf(entity).Elem()
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
 
 	for _, field := range m.modelInfo.Fields {
 		if field.IsPrimary {
@@ -1462,7 +2305,7 @@ func (m *Model[T]) Update(ctx context.Context, entity *T) error {
 		}
 
 		sets = append(sets, field.Column+" = ?")
-		values = append(values, val.FieldByIndex(field.Index).Interface())
+		values = append(values, encodeField(field, val.FieldByIndex(field.Index), layout, dialect))
 	}
 
 	var sb strings.Builder
@@ -1496,7 +2339,7 @@ func (m *Model[T]) Update(ctx context.Context, entity *T) error {
 	if m.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = m.prepareStmtForWrite(ctx, rebind(query))
+		stmt, release, err = m.prepareStmtForWrite(ctx, m.rebind(query))
 		if err != nil {
 			return WrapQueryError("PREPARE", query, values, err)
 		}
@@ -1504,7 +2347,7 @@ func (m *Model[T]) Update(ctx context.Context, entity *T) error {
 
 		_, err = stmt.ExecContext(ctx, allArgs...)
 	} else {
-		_, err = m.queryerForWrite().ExecContext(ctx, rebind(query), allArgs...)
+		_, err = m.queryerForWrite().ExecContext(ctx, m.rebind(query), allArgs...)
 	}
 
 	if err != nil {
@@ -1576,6 +2419,8 @@ func (m *Model[T]) UpdateColumns(ctx context.Context, entity *T, columns ...stri
 	var values []any
 
 	val := reflect.ValueOf(entity).Elem()
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
 
 	for _, column := range columns {
 		field, ok := m.modelInfo.Columns[column]
@@ -1584,7 +2429,7 @@ func (m *Model[T]) UpdateColumns(ctx context.Context, entity *T, columns ...stri
 		}
 
 		sets = append(sets, column+" = ?")
-		values = append(values, val.FieldByIndex(field.Index).Interface())
+		values = append(values, encodeField(field, val.FieldByIndex(field.Index), layout, dialect))
 	}
 
 	if len(sets) == 0 {
@@ -1616,7 +2461,7 @@ func (m *Model[T]) UpdateColumns(ctx context.Context, entity *T, columns ...stri
 	if m.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = m.prepareStmtForWrite(ctx, rebind(query))
+		stmt, release, err = m.prepareStmtForWrite(ctx, m.rebind(query))
 		if err != nil {
 			return WrapQueryError("PREPARE", query, values, err)
 		}
@@ -1624,7 +2469,7 @@ func (m *Model[T]) UpdateColumns(ctx context.Context, entity *T, columns ...stri
 
 		_, err = stmt.ExecContext(ctx, allArgs...)
 	} else {
-		_, err = m.queryerForWrite().ExecContext(ctx, rebind(query), allArgs...)
+		_, err = m.queryerForWrite().ExecContext(ctx, m.rebind(query), allArgs...)
 	}
 
 	if err != nil {
@@ -1642,7 +2487,9 @@ func (m *Model[T]) UpdateColumns(ctx context.Context, entity *T, columns ...stri
 	return nil
 }
 
-// Save persists in-memory changes to entity using a dirty-aware UPDATE.
+// Save inserts entity with Create when its primary key is zero, and otherwise
+// persists in-memory changes using a dirty-aware UPDATE. Either way the
+// entity is tracked afterwards, so it can be modified and saved again.
 //
 // Unlike Update, which writes every non-primary column, Save inspects the
 // dirty-tracking baseline and emits an UPDATE containing only columns whose
@@ -1662,28 +2509,21 @@ func (m *Model[T]) UpdateColumns(ctx context.Context, entity *T, columns ...stri
 // wraparound, Save returns ErrVersionOverflow when the loaded version is at
 // the maximum value of its field type.
 //
-// Save requires:
-//   - a non-zero primary key on entity (use Create for inserts);
-//   - a dirty-tracking baseline, established by loading the entity via
-//     Find / First / Get. A manually-constructed entity is rejected with
-//     ErrSaveUntracked to prevent silent full-column rewrites that would
-//     overwrite columns with zero values. Use Update for full writes.
+// Updating requires a dirty-tracking baseline, established by loading the
+// entity via Find / First / Get or by inserting it with Create or Save. A
+// manually-constructed entity with a non-zero primary key is rejected with
+// ErrSaveUntracked to prevent silent full-column rewrites that would
+// overwrite columns with zero values. Use Update for full writes.
 //
-// Hooks: Save fires BeforeUpdate / AfterUpdate (and their *Tx variants) in
-// the same positions as Update — including when the entity is clean and no
-// SQL is issued. Audit-logging hooks therefore see every Save() call.
+// Hooks: an insert fires the create hooks exactly as Create does. An update
+// fires BeforeUpdate / AfterUpdate (and their *Tx variants) in the same
+// positions as Update — including when the entity is clean and no SQL is
+// issued. Audit-logging hooks therefore see every Save() call.
 func (m *Model[T]) Save(ctx context.Context, entity *T) error {
 	if entity == nil {
 		return ErrNilPointer
 	}
 
-	// Auto-tx: see Create for rationale.
-	if m.tx == nil && needsAutoTx(opUpdate, entity) {
-		return m.withAutoTx(ctx, func(txm *Model[T]) error {
-			return txm.Save(ctx, entity)
-		})
-	}
-
 	val := reflect.ValueOf(entity).Elem()
 
 	pkField, ok := m.modelInfo.Columns[m.modelInfo.PrimaryKey]
@@ -1692,7 +2532,15 @@ func (m *Model[T]) Save(ctx context.Context, entity *T) error {
 	}
 	pkVal := val.FieldByIndex(pkField.Index).Interface()
 	if isZeroPK(pkVal) {
-		return fmt.Errorf("zorm: Save requires non-zero primary key on %s; use Create for inserts", m.modelInfo.TableName)
+		// Create tracks the inserted entity, so a later Save updates it.
+		return m.Create(ctx, entity)
+	}
+
+	// Auto-tx: see Create for rationale.
+	if m.tx == nil && needsAutoTx(opUpdate, entity) {
+		return m.withAutoTx(ctx, func(txm *Model[T]) error {
+			return txm.Save(ctx, entity)
+		})
 	}
 
 	// Reject untracked entities: without a baseline, getDirty would treat every
@@ -1755,9 +2603,12 @@ func (m *Model[T]) Save(ctx context.Context, entity *T) error {
 	sets := make([]string, 0, len(cols)+1) // +1 for optional `version = version + 1`
 	values := make([]any, 0, len(cols)+2)  // +1 for PK, +1 for optional version WHERE arg
 
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
 	for _, col := range cols {
 		sets = append(sets, col+" = ?")
-		values = append(values, dirty[col])
+		field := m.modelInfo.Columns[col]
+		values = append(values, encodeField(field, val.FieldByIndex(field.Index), layout, dialect))
 	}
 
 	var loadedVersion int64
@@ -1804,14 +2655,14 @@ func (m *Model[T]) Save(ctx context.Context, entity *T) error {
 	if m.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = m.prepareStmtForWrite(ctx, rebind(query))
+		stmt, release, err = m.prepareStmtForWrite(ctx, m.rebind(query))
 		if err != nil {
 			return WrapQueryError("PREPARE", query, values, err)
 		}
 		defer release()
 		result, err = stmt.ExecContext(ctx, allArgs...)
 	} else {
-		result, err = m.queryerForWrite().ExecContext(ctx, rebind(query), allArgs...)
+		result, err = m.queryerForWrite().ExecContext(ctx, m.rebind(query), allArgs...)
 	}
 
 	if err != nil {
@@ -1862,7 +2713,7 @@ func (m *Model[T]) Save(ctx context.Context, entity *T) error {
 // ErrOptimisticLock when an optimistic-lock UPDATE matches zero rows.
 func (m *Model[T]) rowExists(ctx context.Context, pkVal any) (bool, error) {
 	q := "SELECT 1 FROM " + m.modelInfo.TableName + " WHERE " + m.modelInfo.PrimaryKey + " = ? LIMIT 1"
-	row := m.queryerForWrite().QueryRowContext(ctx, rebind(q), pkVal)
+	row := m.queryerForWrite().QueryRowContext(ctx, m.rebind(q), pkVal)
 	var one int
 	switch err := row.Scan(&one); err {
 	case nil:
@@ -1893,13 +2744,8 @@ func isZeroPK(v any) bool {
 // At least one WHERE condition is required to prevent accidental full-table deletes.
 // To intentionally delete all records use ForceDeleteAll().
 func (m *Model[T]) Delete(ctx context.Context) error {
-	if m.buildErr != nil {
-		return m.buildErr
-	}
-	if len(m.wheres) == 0 && m.rawQuery == "" {
-		return fmt.Errorf("zorm: %w: Delete requires at least one WHERE condition to prevent accidental full-table deletes; use ForceDeleteAll() to delete all records", ErrInvalidModel)
-	}
-	return m.execDelete(ctx)
+	_, err := m.DeleteManyResult(ctx)
+	return err
 }
 
 // DeleteMany deletes records matching the current query conditions.
@@ -1909,13 +2755,26 @@ func (m *Model[T]) DeleteMany(ctx context.Context) error {
 	return m.Delete(ctx)
 }
 
+// DeleteManyResult is DeleteMany that also returns the number of rows
+// deleted. Like Delete it requires at least one WHERE condition.
+func (m *Model[T]) DeleteManyResult(ctx context.Context) (int64, error) {
+	if m.buildErr != nil {
+		return 0, m.buildErr
+	}
+	if len(m.wheres) == 0 && m.rawQuery == "" {
+		return 0, fmt.Errorf("zorm: %w: Delete requires at least one WHERE condition to prevent accidental full-table deletes; use ForceDeleteAll() to delete all records", ErrInvalidModel)
+	}
+	return m.execDelete(ctx)
+}
+
 // ForceDeleteAll deletes ALL records in the table without any WHERE conditions.
 // Use this only when a full-table delete is intentional.
 func (m *Model[T]) ForceDeleteAll(ctx context.Context) error {
 	if m.buildErr != nil {
 		return m.buildErr
 	}
-	return m.execDelete(ctx)
+	_, err := m.execDelete(ctx)
+	return err
 }
 
 // execDelete is the shared implementation for Delete and ForceDeleteAll.
@@ -1926,58 +2785,179 @@ func (m *Model[T]) ForceDeleteAll(ctx context.Context) error {
 // (not per-entity), the hooks receive a zero-value instance — they are best used for
 // model-level concerns (audit logging, cache invalidation, access control) rather than
 // per-record state inspection.
-func (m *Model[T]) execDelete(ctx context.Context) error {
+func (m *Model[T]) execDelete(ctx context.Context) (int64, error) {
 	// Auto-tx: see Create for rationale. Delete hooks fire on a zero-value *T,
 	// so we probe T (not an entity) for *Tx variants.
 	if m.tx == nil && needsAutoTx(opDelete, new(T)) {
-		return m.withAutoTx(ctx, func(txm *Model[T]) error {
-			return txm.execDelete(ctx)
+		var affected int64
+		err := m.withAutoTx(ctx, func(txm *Model[T]) error {
+			var err error
+			affected, err = txm.execDelete(ctx)
+			return err
 		})
+		return affected, err
 	}
 
 	// BeforeDelete Hook (called on a zero-value instance, not per-entity row).
 	// Prefers BeforeDeleteTx when implemented.
 	hookEntity := new(T)
 	if err := m.callBeforeDelete(ctx, hookEntity); err != nil {
-		return err
+		return 0, err
 	}
 
-	var sb strings.Builder
-	cteArgs := m.buildWithClause(&sb)
-
-	sb.WriteString("DELETE FROM ")
-	sb.WriteString(m.modelInfo.TableName)
-	m.buildWhereClause(&sb)
-
-	query := sb.String()
-	args := append(cteArgs, m.args...)
+	query, args := m.buildDeleteQuery()
 
+	var result sql.Result
 	var err error
 	// Use prepared statement if caching is enabled
 	if m.stmtCache != nil {
 		var stmt *sql.Stmt
 		var release func()
-		stmt, release, err = m.prepareStmtForWrite(ctx, rebind(query))
+		stmt, release, err = m.prepareStmtForWrite(ctx, m.rebind(query))
 		if err != nil {
-			return WrapQueryError("PREPARE", query, m.args, err)
+			return 0, WrapQueryError("PREPARE", query, m.args, err)
 		}
 		defer release()
 
-		_, err = stmt.ExecContext(ctx, args...)
+		result, err = stmt.ExecContext(ctx, args...)
 	} else {
-		_, err = m.queryerForWrite().ExecContext(ctx, rebind(query), args...)
+		result, err = m.queryerForWrite().ExecContext(ctx, m.rebind(query), args...)
 	}
 
 	if err != nil {
-		return WrapQueryError("DELETE", query, m.args, err)
+		return 0, WrapQueryError("DELETE", query, m.args, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, WrapQueryError("DELETE", query, m.args, err)
 	}
 
 	// AfterDelete Hook (prefers AfterDeleteTx when implemented).
 	if err := m.callAfterDelete(ctx, hookEntity); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return affected, nil
+}
+
+// buildDeleteQuery builds the DELETE statement (CTEs, table and WHERE clause)
+// shared by execDelete and DeleteReturning.
+func (m *Model[T]) buildDeleteQuery() (string, []any) {
+	var sb strings.Builder
+	cteArgs := m.buildWithClause(&sb)
+
+	sb.WriteString("DELETE FROM ")
+	sb.WriteString(m.modelInfo.TableName)
+	m.buildWhereClause(&sb)
+
+	return sb.String(), append(cteArgs, m.args...)
+}
+
+// DeleteReturning deletes records matching the current query conditions and
+// returns the deleted rows. At least one WHERE condition is required.
+//
+// PostgreSQL and SQLite use DELETE ... RETURNING *. MySQL has no RETURNING,
+// so the matching rows are selected with FOR UPDATE and then deleted inside
+// a transaction (the model's own transaction when one is set).
+//
+// Hooks fire as for Delete: BeforeDelete/AfterDelete on a zero-value *T.
+//
+// Example:
+//
+//	archived, err := zorm.New[Order]().Where("status", "cancelled").DeleteReturning(ctx)
+func (m *Model[T]) DeleteReturning(ctx context.Context) ([]*T, error) {
+	if m.buildErr != nil {
+		return nil, m.buildErr
+	}
+	if len(m.wheres) == 0 {
+		return nil, fmt.Errorf("zorm: %w: DeleteReturning requires at least one WHERE condition to prevent accidental full-table deletes", ErrInvalidModel)
+	}
+
+	mysql := m.effectiveDialect() == DialectMySQL
+	if m.tx == nil && (mysql || needsAutoTx(opDelete, new(T))) {
+		var results []*T
+		err := m.withAutoTx(ctx, func(txm *Model[T]) error {
+			var err error
+			results, err = txm.DeleteReturning(ctx)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return results, nil
+	}
+
+	hookEntity := new(T)
+	if err := m.callBeforeDelete(ctx, hookEntity); err != nil {
+		return nil, err
+	}
+
+	var results []*T
+	var err error
+	if mysql {
+		results, err = m.selectThenDelete(ctx)
+	} else {
+		results, err = m.deleteWithReturning(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.callAfterDelete(ctx, hookEntity); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// deleteWithReturning runs DELETE ... RETURNING * and scans the deleted rows.
+func (m *Model[T]) deleteWithReturning(ctx context.Context) ([]*T, error) {
+	query, args := m.buildDeleteQuery()
+	query += " RETURNING *"
+
+	rows, err := m.queryerForWrite().QueryContext(ctx, m.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("DELETE", query, args, err)
+	}
+	defer rows.Close()
+
+	results, err := m.scanRows(rows)
+	if err != nil {
+		return nil, WrapQueryError("SCAN", query, args, err)
+	}
+	return results, nil
+}
+
+// selectThenDelete emulates DELETE ... RETURNING for dialects without it. The
+// caller must run it inside a transaction so the SELECT and DELETE see the
+// same rows.
+func (m *Model[T]) selectThenDelete(ctx context.Context) ([]*T, error) {
+	var sb strings.Builder
+	cteArgs := m.buildWithClause(&sb)
+
+	sb.WriteString("SELECT * FROM ")
+	sb.WriteString(m.modelInfo.TableName)
+	m.buildWhereClause(&sb)
+	sb.WriteString(" FOR UPDATE")
+
+	query := sb.String()
+	args := append(cteArgs, m.args...)
+
+	rows, err := m.queryerForWrite().QueryContext(ctx, m.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("SELECT", query, args, err)
+	}
+	results, err := m.scanRows(rows)
+	rows.Close()
+	if err != nil {
+		return nil, WrapQueryError("SCAN", query, args, err)
+	}
+
+	delQuery, delArgs := m.buildDeleteQuery()
+	if _, err := m.queryerForWrite().ExecContext(ctx, m.rebind(delQuery), delArgs...); err != nil {
+		return nil, WrapQueryError("DELETE", delQuery, delArgs, err)
+	}
+	return results, nil
 }
 
 // Exec executes the query (Raw or Builder) and returns the result.
@@ -1991,13 +2971,60 @@ func (m *Model[T]) Exec(ctx context.Context) (sql.Result, error) {
 	return nil, ErrRequiresRawQuery
 }
 
+// RawReturning executes a raw write statement that returns rows, such as
+// INSERT ... RETURNING or UPDATE ... RETURNING, and scans them into []*T.
+// Unlike Raw().Get(), the statement always runs on the primary database.
+//
+// Example:
+//
+//	users, err := zorm.New[User]().RawReturning(ctx,
+//	    "UPDATE users SET active = ? WHERE last_login < ? RETURNING *", false, cutoff)
+func (m *Model[T]) RawReturning(ctx context.Context, query string, args ...any) ([]*T, error) {
+	rows, err := m.queryerForWrite().QueryContext(ctx, m.rebind(query), args...)
+	if err != nil {
+		return nil, WrapQueryError("RAW", query, args, err)
+	}
+	defer rows.Close()
+
+	results, err := m.scanRows(rows)
+	if err != nil {
+		return nil, WrapQueryError("SCAN", query, args, err)
+	}
+	return results, nil
+}
+
 // bulkInsertMaxRowsPerStmt caps the number of rows per single INSERT...VALUES
 // statement. The previous 500-row cap forced an extra round-trip for 1000-row
 // batches; 5000 is well below SQLite's default 32766-variable limit for
-// reasonable column counts and never exceeds Postgres' 65535 (the per-column
-// chunkSize math below catches that case).
+// reasonable column counts; bulkInsertChunkSize lowers it further when the
+// dialect's parameter limit would otherwise be exceeded.
 const bulkInsertMaxRowsPerStmt = 5000
 
+// bulkInsertChunkSize returns how many rows of numColumns placeholders fit in
+// one INSERT under maxParams, capped at bulkInsertMaxRowsPerStmt.
+func bulkInsertChunkSize(maxParams, numColumns int) int {
+	if numColumns <= 0 {
+		numColumns = 1 // Safety to avoid division by zero
+	}
+	chunkSize := maxParams / numColumns
+	if chunkSize > bulkInsertMaxRowsPerStmt {
+		chunkSize = bulkInsertMaxRowsPerStmt
+	} else if chunkSize < 1 {
+		chunkSize = 1
+	}
+	return chunkSize
+}
+
+// insertChunkSize returns the rows per INSERT for numColumns columns: the
+// BatchSize setting when one is given, lowered if needed to stay under the
+// dialect's parameter limit, otherwise bulkInsertChunkSize.
+func (m *Model[T]) insertChunkSize(numColumns int) int {
+	if m.batchSize <= 0 {
+		return bulkInsertChunkSize(m.maxParams(), numColumns)
+	}
+	return min(m.batchSize, max(m.maxParams()/max(numColumns, 1), 1))
+}
+
 // bulkInsertSQLCache memoizes finished INSERT SQL strings keyed by the
 // (table, columns, rowCount, dialect, pk) shape so the hot loop doesn't
 // rebuild the same multi-thousand-byte string per call.
@@ -2090,7 +3117,29 @@ func buildBulkInsertSQL(table, pk string, columns []string, numRows int, dialect
 	return actual.(string)
 }
 
-// CreateMany inserts multiple records in a single query.
+// BatchSize sets how many rows CreateMany, CreateManyNewPK and UpsertMany
+// put in each INSERT statement, overriding the size derived from the
+// dialect's parameter limit. Batches are still shrunk when n rows would
+// exceed that limit. n must be positive.
+//
+// Example:
+//
+//	err := Model[Event]().BatchSize(200).CreateMany(ctx, events)
+func (m *Model[T]) BatchSize(n int) *Model[T] {
+	if n <= 0 {
+		m.buildErr = fmt.Errorf("zorm: BatchSize: batch size must be positive, got %d", n)
+		return m
+	}
+	m.batchSize = n
+	return m
+}
+
+// CreateMany inserts multiple records with multi-row INSERTs, each holding as
+// many rows as fit under the dialect's bound-parameter limit (or BatchSize).
+// Several INSERTs run in one transaction, so the batch is atomic, and
+// generated keys are scanned back into every entity. On dialects without
+// RETURNING (MySQL) each record is inserted on its own inside one
+// transaction, so every entity reads back its own LastInsertId.
 func (m *Model[T]) CreateMany(ctx context.Context, entities []*T) error {
 	return m.createManyImpl(ctx, entities, false)
 }
@@ -2108,15 +3157,19 @@ func (m *Model[T]) CreateManyNewPK(ctx context.Context, entities []*T) error {
 }
 
 func (m *Model[T]) createManyImpl(ctx context.Context, entities []*T, skipPKScan bool) error {
+	if m.buildErr != nil {
+		return m.buildErr
+	}
 	if len(entities) == 0 {
 		return nil
 	}
 
-	// Auto-set created_at per entity (zero-only) before reading field values
+	// Auto-set timestamps per entity (zero-only) before reading field values
 	// into the batch args slice. Same rules as Create.
 	for _, e := range entities {
 		if e != nil {
-			m.autoSetCreatedAt(e)
+			m.autoSetTimestamps(e)
+			m.autoSetUUIDs(e)
 		}
 	}
 
@@ -2138,6 +3191,10 @@ func (m *Model[T]) createManyImpl(ctx context.Context, entities []*T, skipPKScan
 		}
 	}
 
+	if !m.effectiveDialect().SupportsReturning() {
+		return m.createManyLastInsertID(ctx, entities, autoPKField)
+	}
+
 	// Include the auto PK column only if at least one entity has it set.
 	// In skipPKScan mode (CreateManyNewPK), trust the caller and never include.
 	includeAutoPK := false
@@ -2162,18 +3219,7 @@ func (m *Model[T]) createManyImpl(ctx context.Context, entities []*T, skipPKScan
 		fieldsToInsert = append(fieldsToInsert, field)
 	}
 
-	// Determine chunk size based on Postgres limit of 65535 parameters.
-	numColumns := len(columns)
-	if numColumns == 0 {
-		numColumns = 1 // Safety to avoid division by zero
-	}
-
-	chunkSize := 65535 / numColumns
-	if chunkSize > bulkInsertMaxRowsPerStmt {
-		chunkSize = bulkInsertMaxRowsPerStmt
-	} else if chunkSize < 1 {
-		chunkSize = 1
-	}
+	chunkSize := m.insertChunkSize(len(columns))
 
 	if len(entities) <= chunkSize {
 		return m.createBatch(ctx, m.tx, entities, columns, fieldsToInsert)
@@ -2242,6 +3288,7 @@ func (m *Model[T]) createBatch(ctx context.Context, tx *sql.Tx, entities []*T, c
 	argsP := getArgs(len(entities) * len(fieldsToInsert))
 	defer putArgs(argsP)
 	args := *argsP
+	layout := dialect.TimeLayout()
 
 	for _, entity := range entities {
 		val := reflect.ValueOf(entity).Elem()
@@ -2254,7 +3301,7 @@ func (m *Model[T]) createBatch(ctx context.Context, tx *sql.Tx, entities []*T, c
 			} else {
 				fv = val.FieldByIndex(fi.Index)
 			}
-			args = append(args, fv.Interface())
+			args = append(args, encodeField(fi, fv, layout, dialect))
 		}
 	}
 	*argsP = args
@@ -2307,10 +3354,373 @@ func (m *Model[T]) createBatch(ctx context.Context, tx *sql.Tx, entities []*T, c
 	return rows.Err()
 }
 
-// UpdateMany updates records matching the query with values.
+// createManyLastInsertID is CreateMany for dialects without RETURNING. A
+// multi-row INSERT reports a single LastInsertId whose meaning varies by
+// driver (the first row on MySQL, the last on SQLite) and cannot account for
+// rows with an explicit primary key, so each entity is inserted on its own,
+// inside one transaction, and gets its key from its own LastInsertId.
+// Entities that already carry a primary key keep it.
+func (m *Model[T]) createManyLastInsertID(ctx context.Context, entities []*T, autoPKField *FieldInfo) error {
+	if m.tx == nil {
+		return m.withAutoTx(ctx, func(txm *Model[T]) error {
+			return txm.createManyLastInsertID(ctx, entities, autoPKField)
+		})
+	}
+
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
+
+	// One prepared statement per column shape: with and without the
+	// auto-increment primary key.
+	stmts := make(map[bool]*sql.Stmt, 2)
+	queries := make(map[bool]string, 2)
+	defer func() {
+		for _, stmt := range stmts {
+			stmt.Close()
+		}
+	}()
+
+	fields := make([]*FieldInfo, 0, len(m.modelInfo.FieldOrder))
+	for _, entity := range entities {
+		if entity == nil {
+			return ErrNilPointer
+		}
+		val := reflect.ValueOf(entity).Elem()
+		withPK := autoPKField != nil && !val.FieldByIndex(autoPKField.Index).IsZero()
+
+		fields = fields[:0]
+		for _, field := range m.modelInfo.FieldOrder {
+			if field == autoPKField && !withPK {
+				continue
+			}
+			fields = append(fields, field)
+		}
+
+		stmt, ok := stmts[withPK]
+		if !ok {
+			columns := make([]string, len(fields))
+			for i, field := range fields {
+				columns[i] = field.Column
+			}
+			sb := GetStringBuilder()
+			sb.WriteString("INSERT INTO ")
+			sb.WriteString(m.TableName())
+			sb.WriteString(" (")
+			sb.WriteString(strings.Join(columns, ", "))
+			sb.WriteString(") VALUES (")
+			writePlaceholdersWithSeparator(sb, len(columns), ", ")
+			sb.WriteByte(')')
+			query := m.rebind(sb.String())
+			PutStringBuilder(sb)
+
+			var err error
+			if stmt, err = m.tx.PrepareContext(ctx, query); err != nil {
+				return WrapQueryError("PREPARE", query, nil, err)
+			}
+			stmts[withPK] = stmt
+			queries[withPK] = query
+		}
+
+		args := make([]any, len(fields))
+		for i, field := range fields {
+			args[i] = encodeField(field, val.FieldByIndex(field.Index), layout, dialect)
+		}
+		res, err := stmt.ExecContext(ctx, args...)
+		if err != nil {
+			return WrapQueryError("INSERT", queries[withPK], args, err)
+		}
+		if autoPKField != nil && !withPK {
+			if err := setLastInsertID(val.FieldByIndex(autoPKField.Index), res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setLastInsertID stores the id generated by an INSERT without RETURNING
+// into the primary key field pk. A pk that is already set or is not an
+// integer is left alone.
+func setLastInsertID(pk reflect.Value, res sql.Result) error {
+	if !pk.CanSet() || !pk.IsZero() {
+		return nil
+	}
+	switch pk.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	default:
+		return nil
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("zorm: reading LastInsertId: %w", err)
+	}
+	if pk.CanInt() {
+		pk.SetInt(id)
+	} else {
+		pk.SetUint(uint64(id))
+	}
+	return nil
+}
+
+// UpsertMany inserts entities, updating the existing row instead whenever an
+// entity collides with one on conflictColumns (which must be covered by a
+// unique index or constraint). It is the batch form of UpdateOrCreate and runs
+// as INSERT ... ON CONFLICT (...) DO UPDATE, chunked to stay under the
+// dialect's parameter limit. All chunks run in one transaction, opened here
+// unless the model is already bound to one.
+//
+// Every non-conflict column is overwritten from the entity except created_at,
+// which keeps the value of the existing row. The auto-increment primary key
+// is only written when it is itself one of the conflict columns. Zero UUID
+// fields are filled as in CreateMany. The resulting primary key (new or
+// existing) is scanned back into each entity, matched on the conflict
+// columns since RETURNING does not preserve VALUES order.
+//
+// An entity batch must not contain two entities with the same conflict key;
+// PostgreSQL rejects a statement that would update the same row twice.
+// MySQL is not supported.
+//
+// Example:
+//
+//	err := Model[User]().UpsertMany(ctx, users, []string{"email"})
+func (m *Model[T]) UpsertMany(ctx context.Context, entities []*T, conflictColumns []string) error {
+	if m.buildErr != nil {
+		return m.buildErr
+	}
+	if len(entities) == 0 {
+		return nil
+	}
+	if len(conflictColumns) == 0 {
+		return fmt.Errorf("zorm: %w: UpsertMany requires at least one conflict column", ErrInvalidModel)
+	}
+	conflict := make(map[string]bool, len(conflictColumns))
+	for _, col := range conflictColumns {
+		if err := ValidateColumnName(col); err != nil {
+			return fmt.Errorf("zorm: UpsertMany: invalid conflict column %q: %w", col, err)
+		}
+		if _, ok := m.modelInfo.Columns[col]; !ok {
+			return fmt.Errorf("zorm: UpsertMany: %w: %q", ErrColumnNotFound, col)
+		}
+		conflict[col] = true
+	}
+	if m.effectiveDialect() == DialectMySQL {
+		return fmt.Errorf("zorm: UpsertMany: ON CONFLICT is not supported on MySQL")
+	}
+	for _, e := range entities {
+		if e == nil {
+			return ErrNilPointer
+		}
+	}
+
+	if m.tx == nil {
+		return m.withAutoTx(ctx, func(txm *Model[T]) error {
+			return txm.UpsertMany(ctx, entities, conflictColumns)
+		})
+	}
+
+	for _, e := range entities {
+		m.autoSetTimestamps(e)
+		m.autoSetUUIDs(e)
+	}
+
+	columns := make([]string, 0, len(m.modelInfo.Fields))
+	fields := make([]*FieldInfo, 0, len(m.modelInfo.Fields))
+	updates := make([]string, 0, len(m.modelInfo.Fields))
+	for _, field := range m.modelInfo.Fields {
+		if field.IsPrimary && field.IsAuto && !conflict[field.Column] {
+			continue
+		}
+		columns = append(columns, field.Column)
+		fields = append(fields, field)
+		if !conflict[field.Column] && !field.IsPrimary && field.Column != "created_at" {
+			updates = append(updates, field.Column+" = excluded."+field.Column)
+		}
+	}
+	// DO NOTHING would skip RETURNING for existing rows, so fall back to a
+	// no-op assignment to keep one returned key per entity.
+	if len(updates) == 0 {
+		updates = append(updates, conflictColumns[0]+" = excluded."+conflictColumns[0])
+	}
+
+	pkField, ok := m.modelInfo.Columns[m.modelInfo.PrimaryKey]
+	if !ok {
+		return fmt.Errorf("primary key field %q not found in model %s", m.modelInfo.PrimaryKey, m.modelInfo.TableName)
+	}
+
+	chunkSize := m.insertChunkSize(len(columns))
+	for i := 0; i < len(entities); i += chunkSize {
+		end := min(i+chunkSize, len(entities))
+		if err := m.upsertBatch(ctx, entities[i:end], columns, fields, conflictColumns, updates, pkField); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upsertBatch runs a single INSERT ... ON CONFLICT statement for UpsertMany
+// and scans the returned primary keys back into entities, pairing each
+// returned row with the entity that has the same conflict key.
+func (m *Model[T]) upsertBatch(ctx context.Context, entities []*T, columns []string, fields []*FieldInfo, conflictColumns, updates []string, pkField *FieldInfo) error {
+	sb := GetStringBuilder()
+	sb.WriteString("INSERT INTO ")
+	sb.WriteString(m.modelInfo.TableName)
+	sb.WriteString(" (")
+	sb.WriteString(strings.Join(columns, ", "))
+	sb.WriteString(") VALUES ")
+	args := make([]any, 0, len(entities)*len(fields))
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
+	for i, entity := range entities {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		writePlaceholdersWithSeparator(sb, len(columns), ", ")
+		sb.WriteByte(')')
+		val := reflect.ValueOf(entity).Elem()
+		for _, fi := range fields {
+			args = append(args, encodeField(fi, val.FieldByIndex(fi.Index), layout, dialect))
+		}
+	}
+	sb.WriteString(" ON CONFLICT (")
+	sb.WriteString(strings.Join(conflictColumns, ", "))
+	sb.WriteString(") DO UPDATE SET ")
+	sb.WriteString(strings.Join(updates, ", "))
+	sb.WriteString(" RETURNING ")
+	sb.WriteString(m.modelInfo.PrimaryKey)
+	sb.WriteString(", ")
+	sb.WriteString(strings.Join(conflictColumns, ", "))
+	query := sb.String()
+	PutStringBuilder(sb)
+
+	conflictFields := make([]*FieldInfo, len(conflictColumns))
+	for i, col := range conflictColumns {
+		conflictFields[i] = m.modelInfo.Columns[col]
+	}
+	// Entities sharing a key (only possible when it contains NULLs, which
+	// never conflict) are paired with returned rows in VALUES order.
+	pending := make(map[string][]*T, len(entities))
+	keyVals := make([]reflect.Value, len(conflictFields))
+	for _, entity := range entities {
+		val := reflect.ValueOf(entity).Elem()
+		for i, fi := range conflictFields {
+			keyVals[i] = val.FieldByIndex(fi.Index)
+		}
+		key := upsertKey(keyVals)
+		pending[key] = append(pending[key], entity)
+	}
+
+	rows, err := m.queryerForWrite().QueryContext(ctx, m.rebind(query), args...)
+	if err != nil {
+		return WrapQueryError("UPSERT", query, args, err)
+	}
+	defer rows.Close()
+
+	pk := reflect.New(pkField.FieldType)
+	dest := make([]any, 1+len(conflictFields))
+	dest[0] = pk.Interface()
+	for i, fi := range conflictFields {
+		keyVals[i] = reflect.New(fi.FieldType).Elem()
+		dest[i+1] = keyVals[i].Addr().Interface()
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return WrapQueryError("SCAN", query, args, err)
+		}
+		key := upsertKey(keyVals)
+		matched := pending[key]
+		if len(matched) == 0 {
+			return fmt.Errorf("zorm: UpsertMany: returned row with conflict key %s matches no entity", key)
+		}
+		pending[key] = matched[1:]
+		fVal := reflect.ValueOf(matched[0]).Elem().FieldByIndex(pkField.Index)
+		if fVal.CanSet() {
+			fVal.Set(pk.Elem())
+		}
+	}
+	return rows.Err()
+}
+
+// upsertKey renders conflict column values as a map key, so entity fields
+// and the same columns scanned back from RETURNING compare equal. Pointers
+// are dereferenced, nil renders as NULL and times are normalised to UTC.
+func upsertKey(vals []reflect.Value) string {
+	sb := GetStringBuilder()
+	defer PutStringBuilder(sb)
+	for i, v := range vals {
+		if i > 0 {
+			sb.WriteByte(0)
+		}
+		v = reflect.Indirect(v)
+		if !v.IsValid() {
+			sb.WriteString("NULL")
+			continue
+		}
+		if t, ok := v.Interface().(time.Time); ok {
+			sb.WriteString(t.UTC().Format(time.RFC3339Nano))
+			continue
+		}
+		fmt.Fprintf(sb, "%v", v.Interface())
+	}
+	return sb.String()
+}
+
+// RawExpr is an UPDATE value emitted verbatim into the SET clause instead
+// of being bound as a placeholder. Build one with Raw.
+type RawExpr struct {
+	SQL  string
+	Args []any
+}
+
+// Raw wraps a SQL expression for use as a value in UpdateMany and the extra
+// assignments of IncrementWith/DecrementWith. Each ? in expr is bound to the
+// next arg, in order.
+//
+// Example:
+//
+//	New[Post]().Where("id", id).UpdateMany(ctx, map[string]any{
+//	    "views":     zorm.Raw("views + ?", 1),
+//	    "synced_at": zorm.Raw("CURRENT_TIMESTAMP"),
+//	    "status":    "published",
+//	})
+func Raw(expr string, args ...any) RawExpr {
+	return RawExpr{SQL: expr, Args: args}
+}
+
+// writeAssignment writes `col = ?` for a literal value, or `col = expr` for
+// a RawExpr, and returns the args it binds.
+func writeAssignment(sb *strings.Builder, col string, v any) ([]any, error) {
+	sb.WriteString(col)
+	raw, ok := v.(RawExpr)
+	if !ok {
+		sb.WriteString(" = ?")
+		return []any{v}, nil
+	}
+	if strings.TrimSpace(raw.SQL) == "" {
+		return nil, fmt.Errorf("zorm: %w: raw expression for column %q is empty", ErrInvalidModel, col)
+	}
+	if n := strings.Count(raw.SQL, "?"); n != len(raw.Args) {
+		return nil, fmt.Errorf("zorm: %w: raw expression for column %q has %d placeholders but %d args were given", ErrInvalidModel, col, n, len(raw.Args))
+	}
+	sb.WriteString(" = ")
+	sb.WriteString(raw.SQL)
+	return raw.Args, nil
+}
+
+// UpdateMany updates records matching the query with values. A value built
+// with Raw is written into the SET clause as SQL rather than bound.
 func (m *Model[T]) UpdateMany(ctx context.Context, values map[string]any) error {
+	_, err := m.UpdateManyResult(ctx, values)
+	return err
+}
+
+// UpdateManyResult is UpdateMany that also returns the number of rows
+// affected, e.g. to detect an update that matched nothing. Empty values
+// are a no-op that reports 0.
+func (m *Model[T]) UpdateManyResult(ctx context.Context, values map[string]any) (int64, error) {
 	if len(values) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	// Copy the map to avoid mutating the caller's map when we inject updated_at.
@@ -2325,20 +3735,26 @@ func (m *Model[T]) UpdateMany(ctx context.Context, values map[string]any) error
 		}
 	}
 
+	// Sorted so the same set of columns always produces the same SQL.
+	cols := slices.Sorted(maps.Keys(values))
+
 	var sets []string
 	var setArgs []any
 
-	for k, v := range values {
+	for _, k := range cols {
+		v := values[k]
 		if err := ValidateColumnName(k); err != nil {
-			return err
+			return 0, err
 		}
 
 		setSb := GetStringBuilder()
-		setSb.WriteString(k)
-		setSb.WriteString(" = ?")
+		bound, err := writeAssignment(setSb, k, v)
 		sets = append(sets, setSb.String())
 		PutStringBuilder(setSb)
-		setArgs = append(setArgs, v)
+		if err != nil {
+			return 0, err
+		}
+		setArgs = append(setArgs, bound...)
 	}
 
 	var sb strings.Builder
@@ -2358,11 +3774,149 @@ func (m *Model[T]) UpdateMany(ctx context.Context, values map[string]any) error
 	args = append(args, m.args...)
 
 	query := sb.String()
-	_, err := m.queryerForWrite().ExecContext(ctx, rebind(query), args...)
+	result, err := m.queryerForWrite().ExecContext(ctx, m.rebind(query), args...)
 	if err != nil {
-		return WrapQueryError("UPDATE", query, args, err)
+		return 0, WrapQueryError("UPDATE", query, args, err)
 	}
-	return nil
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, WrapQueryError("UPDATE", query, args, err)
+	}
+	return affected, nil
+}
+
+// Increment adds amount (default 1) to column on every row matching the
+// current WHERE conditions, i.e. `UPDATE t SET col = col + ? WHERE ...`, and
+// returns the number of rows affected. updated_at is refreshed when the
+// model has one, as with UpdateMany.
+//
+// Example:
+//
+//	n, err := New[Post]().Where("id", id).Increment(ctx, "views")
+//	n, err := New[Account]().Where("id", id).Increment(ctx, "balance", 250)
+func (m *Model[T]) Increment(ctx context.Context, column string, amount ...any) (int64, error) {
+	delta, err := incrementAmount("Increment", amount)
+	if err != nil {
+		return 0, err
+	}
+	return m.adjustColumn(ctx, "Increment", column, "+", delta, nil)
+}
+
+// Decrement subtracts amount (default 1) from column on every row matching
+// the current WHERE conditions and returns the number of rows affected.
+// See Increment.
+func (m *Model[T]) Decrement(ctx context.Context, column string, amount ...any) (int64, error) {
+	delta, err := incrementAmount("Decrement", amount)
+	if err != nil {
+		return 0, err
+	}
+	return m.adjustColumn(ctx, "Decrement", column, "-", delta, nil)
+}
+
+// IncrementWith is Increment with additional column assignments applied in
+// the same UPDATE statement.
+//
+// Example:
+//
+//	New[Post]().Where("id", id).IncrementWith(ctx, "views", 1, map[string]any{"last_viewed_at": now})
+func (m *Model[T]) IncrementWith(ctx context.Context, column string, amount any, extra map[string]any) (int64, error) {
+	return m.adjustColumn(ctx, "IncrementWith", column, "+", amount, extra)
+}
+
+// DecrementWith is Decrement with additional column assignments applied in
+// the same UPDATE statement.
+func (m *Model[T]) DecrementWith(ctx context.Context, column string, amount any, extra map[string]any) (int64, error) {
+	return m.adjustColumn(ctx, "DecrementWith", column, "-", amount, extra)
+}
+
+// incrementAmount resolves the optional amount argument of Increment and
+// Decrement.
+func incrementAmount(method string, amount []any) (any, error) {
+	switch len(amount) {
+	case 0:
+		return 1, nil
+	case 1:
+		return amount[0], nil
+	default:
+		return nil, fmt.Errorf("zorm: %w: %s accepts at most one amount, got %d", ErrInvalidModel, method, len(amount))
+	}
+}
+
+// adjustColumn runs `UPDATE t SET col = col <op> ?, extra... WHERE ...`.
+func (m *Model[T]) adjustColumn(ctx context.Context, method, column, op string, amount any, extra map[string]any) (int64, error) {
+	if m.buildErr != nil {
+		return 0, m.buildErr
+	}
+	if err := ValidateColumnName(column); err != nil {
+		return 0, fmt.Errorf("zorm: %s: invalid column %q: %w", method, column, err)
+	}
+	switch reflect.ValueOf(amount).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return 0, fmt.Errorf("zorm: %w: %s amount must be a number, got %T", ErrInvalidModel, method, amount)
+	}
+
+	extraCols := make([]string, 0, len(extra)+1)
+	for col := range extra {
+		if err := ValidateColumnName(col); err != nil {
+			return 0, fmt.Errorf("zorm: %s: invalid column %q: %w", method, col, err)
+		}
+		if col == column {
+			return 0, fmt.Errorf("zorm: %w: %s: column %q is both adjusted and assigned", ErrInvalidModel, method, col)
+		}
+		extraCols = append(extraCols, col)
+	}
+	sort.Strings(extraCols)
+
+	var sb strings.Builder
+	cteArgs := m.buildWithClause(&sb)
+
+	sb.WriteString("UPDATE ")
+	sb.WriteString(m.TableName())
+	sb.WriteString(" SET ")
+	sb.WriteString(column)
+	sb.WriteString(" = ")
+	sb.WriteString(column)
+	sb.WriteByte(' ')
+	sb.WriteString(op)
+	sb.WriteString(" ?")
+
+	setArgs := []any{amount}
+	for _, col := range extraCols {
+		sb.WriteString(", ")
+		bound, err := writeAssignment(&sb, col, extra[col])
+		if err != nil {
+			return 0, err
+		}
+		setArgs = append(setArgs, bound...)
+	}
+	// Auto-update updated_at if it exists and not provided
+	if _, ok := m.modelInfo.Columns["updated_at"]; ok && column != "updated_at" {
+		if _, exists := extra["updated_at"]; !exists {
+			sb.WriteString(", updated_at = ?")
+			setArgs = append(setArgs, time.Now())
+		}
+	}
+
+	m.buildWhereClause(&sb)
+
+	args := make([]any, 0, len(cteArgs)+len(setArgs)+len(m.args))
+	args = append(args, cteArgs...)
+	args = append(args, setArgs...)
+	args = append(args, m.args...)
+
+	query := sb.String()
+	result, err := m.queryerForWrite().ExecContext(ctx, m.rebind(query), args...)
+	if err != nil {
+		return 0, WrapQueryError("UPDATE", query, args, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, WrapQueryError("UPDATE", query, args, err)
+	}
+	return affected, nil
 }
 
 // UpdateManyByKey updates multiple records by matching a lookup column to values in a map.
@@ -2404,7 +3958,7 @@ func (m *Model[T]) UpdateManyByKey(ctx context.Context, lookupColumn, targetColu
 	// Calculate chunking threshold
 	// Each entry needs: 2 params for CASE (key, value) + 1 param for WHERE IN = 3 params
 	paramsPerEntry := 3
-	maxEntriesPerBatch := (65535 - 10) / paramsPerEntry // Reserve buffer
+	maxEntriesPerBatch := (m.maxParams() - 10) / paramsPerEntry // Reserve buffer
 	if maxEntriesPerBatch > 500 {
 		maxEntriesPerBatch = 500 // Cap for reasonable batch size
 	}
@@ -2476,7 +4030,7 @@ func (m *Model[T]) updateManyByKeyBatch(ctx context.Context, lookupColumn, targe
 	args = append(args, lookupKeys...)
 
 	query := sb.String()
-	_, err := m.queryerForWrite().ExecContext(ctx, rebind(query), args...)
+	_, err := m.queryerForWrite().ExecContext(ctx, m.rebind(query), args...)
 	if err != nil {
 		return WrapQueryError("UPDATE", query, args, err)
 	}
@@ -2589,9 +4143,13 @@ func (m *Model[T]) BulkInsert(ctx context.Context, entities []*T) error {
 		}
 		sb.WriteByte('?')
 	}
-	sb.WriteString(") RETURNING ")
-	sb.WriteString(m.modelInfo.PrimaryKey)
-	insertQuery := rebind(sb.String())
+	sb.WriteByte(')')
+	returning := m.effectiveDialect().SupportsReturning()
+	if returning {
+		sb.WriteString(" RETURNING ")
+		sb.WriteString(m.modelInfo.PrimaryKey)
+	}
+	insertQuery := m.rebind(sb.String())
 	PutStringBuilder(sb)
 
 	// Get database connection for preparing
@@ -2624,6 +4182,8 @@ func (m *Model[T]) BulkInsert(ctx context.Context, entities []*T) error {
 
 	// Pre-allocate args slice
 	args := make([]any, len(fieldsToInsert))
+	dialect := m.effectiveDialect()
+	layout := dialect.TimeLayout()
 
 	// Execute for each entity
 	for _, entity := range entities {
@@ -2631,16 +4191,24 @@ func (m *Model[T]) BulkInsert(ctx context.Context, entities []*T) error {
 
 		// Extract values using cached field indices
 		for i, field := range fieldsToInsert {
-			args[i] = val.FieldByIndex(field.Index).Interface()
+			args[i] = encodeField(field, val.FieldByIndex(field.Index), layout, dialect)
 		}
 
 		// Execute and scan returned ID
 		fVal := val.FieldByIndex(pkField.Index)
-		if fVal.CanSet() {
+		if fVal.CanSet() && returning {
 			err = stmt.QueryRowContext(ctx, args...).Scan(fVal.Addr().Interface())
 			if err != nil {
 				return WrapQueryError("INSERT", insertQuery, args, err)
 			}
+		} else if fVal.CanSet() {
+			res, err := stmt.ExecContext(ctx, args...)
+			if err != nil {
+				return WrapQueryError("INSERT", insertQuery, args, err)
+			}
+			if err := setLastInsertID(fVal, res); err != nil {
+				return err
+			}
 		} else {
 			_, err = stmt.ExecContext(ctx, args...)
 			if err != nil {
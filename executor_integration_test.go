@@ -3,10 +3,12 @@ package zorm
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -66,6 +68,148 @@ func TestExecutor_Aggregates(t *testing.T) {
 	}
 }
 
+func TestExecutor_AggregateExpressions(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	m := New[ExModel]().SetDB(db)
+	ctx := context.Background()
+
+	if sum, err := m.Sum(ctx, "value * 2"); err != nil || sum != 120 {
+		t.Errorf("Sum(value * 2): got %f, err %v", sum, err)
+	}
+	if avg, err := m.Avg(ctx, "(value - 10) / 10"); err != nil || avg != 1 {
+		t.Errorf("Avg((value - 10) / 10): got %f, err %v", avg, err)
+	}
+}
+
+func TestExecutor_AggregatesRejectInvalidColumns(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	m := New[ExModel]().SetDB(db)
+	ctx := context.Background()
+	const malicious = "value) FROM ex_models; DROP TABLE ex_models; --"
+
+	rec, stop := RecordQueries(ctx)
+	calls := map[string]func() error{
+		"Sum":       func() error { _, err := m.Sum(ctx, malicious); return err },
+		"Avg":       func() error { _, err := m.Avg(ctx, malicious); return err },
+		"Max":       func() error { _, err := m.Max(ctx, malicious); return err },
+		"CountOver": func() error { _, err := m.CountOver(ctx, malicious); return err },
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, ErrInvalidColumnName) {
+			t.Errorf("%s: expected ErrInvalidColumnName, got %v", name, err)
+		}
+	}
+	stop()
+	if err := rec.AssertQueryCount(0); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := New[ExModel]().SetDB(db).Select("bad;col").Sum(ctx, "value"); err == nil {
+		t.Error("expected Sum to return the builder error")
+	}
+}
+
+func TestExecutor_MinMax(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		ALTER TABLE ex_models ADD COLUMN born TEXT;
+		UPDATE ex_models SET born = '2021-03-01' WHERE id = 1;
+		UPDATE ex_models SET born = '2019-07-15' WHERE id = 2;
+	`)
+	if err != nil {
+		t.Fatalf("failed to add date column: %v", err)
+	}
+
+	m := New[ExModel]().SetDB(db)
+	ctx := context.Background()
+
+	// Numeric, respecting WHERE
+	lo, err := m.Min(ctx, "value")
+	if err != nil || !lo.Valid || lo.Float64 != 10 {
+		t.Errorf("Min failed: got %+v, err %v", lo, err)
+	}
+	hi, err := m.Clone().Where("value", "<", 30).Max(ctx, "value")
+	if err != nil || !hi.Valid || hi.Float64 != 20 {
+		t.Errorf("Max with WHERE failed: got %+v, err %v", hi, err)
+	}
+
+	// Dates stored as text; NULLs are ignored
+	earliest, err := m.MinValue(ctx, "born")
+	if err != nil || earliest != "2019-07-15" {
+		t.Errorf("MinValue failed: got %v, err %v", earliest, err)
+	}
+	latest, err := m.MaxValue(ctx, "born")
+	if err != nil || latest != "2021-03-01" {
+		t.Errorf("MaxValue failed: got %v, err %v", latest, err)
+	}
+
+	// Only NULLs, and no rows at all
+	nullOnly, err := m.Clone().Where("id", 3).Min(ctx, "born")
+	if err != nil || nullOnly.Valid {
+		t.Errorf("expected invalid Min over NULL-only rows, got %+v, err %v", nullOnly, err)
+	}
+	none, err := m.Clone().Where("id", 999).MaxValue(ctx, "born")
+	if err != nil || none != nil {
+		t.Errorf("expected nil MaxValue for no rows, got %v, err %v", none, err)
+	}
+
+	if _, err := m.Min(ctx, "value; DROP TABLE ex_models"); err == nil {
+		t.Error("expected error for invalid column")
+	}
+}
+
+func TestExecutor_AggregateOver(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`INSERT INTO ex_models (value, name) VALUES (5, 'A'), (7, 'B'), (100, NULL)`); err != nil {
+		t.Fatalf("failed to insert rows: %v", err)
+	}
+
+	ctx := context.Background()
+	sums, err := AggregateOver[string](ctx, New[ExModel]().SetDB(db), "name", "sum(value)")
+	if err != nil {
+		t.Fatalf("AggregateOver failed: %v", err)
+	}
+	want := map[string]float64{"A": 15, "B": 27, "C": 30}
+	if len(sums) != len(want) {
+		t.Fatalf("expected %v, got %v", want, sums)
+	}
+	for k, v := range want {
+		if sums[k] != v {
+			t.Errorf("group %q: expected %v, got %v", k, v, sums[k])
+		}
+	}
+
+	counts, err := AggregateOver[string](ctx, New[ExModel]().SetDB(db).Where("value", ">", 6), "name", "COUNT(*)")
+	if err != nil {
+		t.Fatalf("AggregateOver COUNT failed: %v", err)
+	}
+	if counts["A"] != 1 || counts["B"] != 2 || counts["C"] != 1 {
+		t.Errorf("unexpected filtered counts: %v", counts)
+	}
+
+	byValue, err := AggregateOver[int64](ctx, New[ExModel]().SetDB(db), "value", "MAX(id)")
+	if err != nil {
+		t.Fatalf("AggregateOver with int keys failed: %v", err)
+	}
+	if byValue[10] != 1 || byValue[100] != 6 {
+		t.Errorf("unexpected int-keyed result: %v", byValue)
+	}
+
+	for _, expr := range []string{"SUM(value); DROP TABLE ex_models", "STRING_AGG(name)", "SUM(*)", "SUM(a, b)", "value"} {
+		if _, err := AggregateOver[string](ctx, New[ExModel]().SetDB(db), "name", expr); err == nil {
+			t.Errorf("expected error for aggregate %q", expr)
+		}
+	}
+}
+
 func TestExecutor_FindOrFail(t *testing.T) {
 	db := setupExDB(t)
 	defer db.Close()
@@ -89,6 +233,148 @@ func TestExecutor_FindOrFail(t *testing.T) {
 	}
 }
 
+func TestExecutor_FirstWhere(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	m := New[ExModel]().SetDB(db).Where("value", ">", 10)
+	ctx := context.Background()
+
+	res, err := m.FirstWhere(ctx, "name", "B")
+	if err != nil || res.Value != 20 {
+		t.Fatalf("FirstWhere(name, B): expected value 20, got %+v (%v)", res, err)
+	}
+
+	// The existing value > 10 filter still applies, so A is not found.
+	if _, err := m.FirstWhereOrFail(ctx, "name", "A"); !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+
+	if len(m.wheres) != 1 || len(m.args) != 1 {
+		t.Errorf("expected the builder to be left unchanged, got wheres %v args %v", m.wheres, m.args)
+	}
+	if res, err := m.FirstWhere(ctx, "name", "C"); err != nil || res.Value != 30 {
+		t.Errorf("reused builder: expected value 30, got %+v (%v)", res, err)
+	}
+
+	if _, err := m.FirstWhere(ctx, "name; DROP TABLE ex_models", "A"); err == nil || errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("expected an invalid column error, got %v", err)
+	}
+}
+
+func TestExecutor_FindInto(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	m := New[ExModel]().SetDB(db)
+	ctx := context.Background()
+
+	// Pre-populated to mimic a struct reused from a pool
+	dest := &ExModel{ID: 99, Value: 99, Name: "stale"}
+	if err := m.FindInto(ctx, 2, dest); err != nil {
+		t.Fatalf("FindInto(2) failed: %v", err)
+	}
+	if dest.ID != 2 || dest.Value != 20 || dest.Name != "B" {
+		t.Errorf("unexpected entity after FindInto: %+v", *dest)
+	}
+
+	// Not exists leaves dest untouched
+	err := m.FindInto(ctx, 999, dest)
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+	if dest.ID != 2 {
+		t.Errorf("expected dest untouched on miss, got %+v", *dest)
+	}
+
+	if err := m.FindInto(ctx, 1, nil); err == nil {
+		t.Error("expected error for nil destination")
+	}
+}
+
+func TestExecutor_Sole(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// Exactly one
+	res, err := New[ExModel]().SetDB(db).Where("name", "B").Sole(ctx)
+	if err != nil || res.Value != 20 {
+		t.Errorf("Sole() for one match failed: %+v, %v", res, err)
+	}
+
+	// None
+	_, err = New[ExModel]().SetDB(db).Where("name", "Z").Sole(ctx)
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+
+	// Many
+	m := New[ExModel]().SetDB(db).Where("value", ">", 10)
+	_, err = m.Sole(ctx)
+	if !errors.Is(err, ErrMultipleRecordsFound) {
+		t.Errorf("expected ErrMultipleRecordsFound, got %v", err)
+	}
+
+	// Sole must not mutate the original limit
+	results, err := m.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(results) != 2 || m.limit != 0 {
+		t.Errorf("expected 2 results after Sole(), got %d (Sole() mutated original)", len(results))
+	}
+}
+
+func TestExecutor_Batch(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+	db.SetMaxOpenConns(1) // keep the in-memory database on one connection
+
+	m := New[ExModel]().SetDB(db)
+	ctx := context.Background()
+
+	created := &ExModel{Value: 40, Name: "D"}
+	first, err := New[ExModel]().SetDB(db).Find(ctx, 1)
+	if err != nil {
+		t.Fatalf("Find(1) failed: %v", err)
+	}
+	first.Value = 11
+	third := &ExModel{ID: 3}
+
+	if err := m.Batch().Create(created).Update(first).Delete(third).Execute(ctx); err != nil {
+		t.Fatalf("Batch.Execute failed: %v", err)
+	}
+	if created.ID == 0 {
+		t.Error("expected created entity to receive an ID")
+	}
+	rows, err := New[ExModel]().SetDB(db).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []ExModel
+	for _, r := range rows {
+		got = append(got, *r)
+	}
+	if len(got) != 3 || got[0].Value != 11 || got[1].ID != 2 || got[2].Name != "D" {
+		t.Errorf("unexpected rows after batch: %+v", got)
+	}
+
+	// A failing operation rolls back the ones queued before it
+	err = m.Batch().Create(&ExModel{Value: 50, Name: "E"}).Delete(nil).Execute(ctx)
+	if !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("expected ErrNilPointer, got %v", err)
+	}
+	count, err := New[ExModel]().SetDB(db).Where("name", "E").Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected failed batch to be rolled back, found %d rows", count)
+	}
+}
+
 func TestExecutor_Cursor(t *testing.T) {
 	db := setupExDB(t)
 	defer db.Close()
@@ -480,81 +766,692 @@ type tsModelBoth struct {
 	CreatedAt time.Time `zorm:"column:created_at"`
 }
 
-func (tsModelBoth) TableName() string { return "ts_models_both" }
-
-func TestUpdate_AutoSetsUpdatedAt(t *testing.T) {
+func (tsModelBoth) TableName() string { return "ts_models_both" }
+
+func TestUpdate_AutoSetsUpdatedAt(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE ts_models_both (
+			id INTEGER PRIMARY KEY,
+			name TEXT,
+			age INTEGER,
+			updated_at DATETIME,
+			created_at DATETIME
+		);
+		INSERT INTO ts_models_both (id, name, age, updated_at, created_at) VALUES
+			(1, 'orig', 30, '2020-01-01 00:00:00', '2020-01-01 00:00:00');
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// Load the existing row so CreatedAt is non-zero and UpdatedAt reflects
+	// the seeded 2020 value.
+	row, err := New[tsModelBoth]().SetDB(db).Where("id", 1).First(ctx)
+	if err != nil {
+		t.Fatalf("First failed: %v", err)
+	}
+	seededCreatedAt := row.CreatedAt
+	seededUpdatedAt := row.UpdatedAt
+
+	// Mutate a non-timestamp field; do NOT touch UpdatedAt.
+	row.Name = "changed"
+
+	beforeUpdate := time.Now()
+	if err := New[tsModelBoth]().SetDB(db).Update(ctx, row); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	// Re-fetch and assert updated_at advanced to ~time.Now() and is not the
+	// zero time.Time value.
+	after, err := New[tsModelBoth]().SetDB(db).Where("id", 1).First(ctx)
+	if err != nil {
+		t.Fatalf("re-fetch failed: %v", err)
+	}
+
+	if after.UpdatedAt.IsZero() {
+		t.Fatalf("expected updated_at to be auto-set, got zero value")
+	}
+	if after.UpdatedAt.Equal(seededUpdatedAt) {
+		t.Fatalf("expected updated_at to change from seeded %v, still %v", seededUpdatedAt, after.UpdatedAt)
+	}
+	// Allow a small clock skew window; updated_at should be at or after
+	// beforeUpdate (truncated to second since sqlite DATETIME loses sub-second
+	// precision depending on driver formatting).
+	if after.UpdatedAt.Before(beforeUpdate.Truncate(time.Second)) {
+		t.Errorf("expected updated_at >= %v, got %v", beforeUpdate, after.UpdatedAt)
+	}
+
+	// Sanity: created_at must not be overwritten by Update.
+	if !after.CreatedAt.Equal(seededCreatedAt) {
+		t.Errorf("created_at should be unchanged by Update; was %v, now %v", seededCreatedAt, after.CreatedAt)
+	}
+
+	// Sanity: the non-timestamp mutation was persisted.
+	if after.Name != "changed" {
+		t.Errorf("expected name 'changed', got %q", after.Name)
+	}
+}
+
+func TestCreate_AutoSetsCreatedAt(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE ts_models_both (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT,
+			age INTEGER,
+			updated_at DATETIME,
+			created_at DATETIME
+		);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("zero CreatedAt is auto-populated", func(t *testing.T) {
+		before := time.Now()
+		row := &tsModelBoth{Name: "alice", Age: 30}
+		if err := New[tsModelBoth]().SetDB(db).Create(ctx, row); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		if row.CreatedAt.IsZero() {
+			t.Fatalf("expected created_at to be auto-set on entity, got zero")
+		}
+		if row.CreatedAt.Before(before.Truncate(time.Second)) {
+			t.Errorf("expected created_at >= %v, got %v", before, row.CreatedAt)
+		}
+
+		if !row.UpdatedAt.Equal(row.CreatedAt) {
+			t.Errorf("expected updated_at = created_at on insert, got %v and %v", row.UpdatedAt, row.CreatedAt)
+		}
+
+		fetched, err := New[tsModelBoth]().SetDB(db).Where("id", row.ID).First(ctx)
+		if err != nil {
+			t.Fatalf("First failed: %v", err)
+		}
+		if fetched.CreatedAt.IsZero() {
+			t.Fatalf("expected created_at persisted to DB, got zero")
+		}
+		if fetched.UpdatedAt.IsZero() {
+			t.Fatalf("expected updated_at persisted to DB, got zero")
+		}
+	})
+
+	t.Run("pre-set CreatedAt is preserved", func(t *testing.T) {
+		seeded := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		touched := time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)
+		row := &tsModelBoth{Name: "bob", Age: 40, CreatedAt: seeded, UpdatedAt: touched}
+		if err := New[tsModelBoth]().SetDB(db).Create(ctx, row); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		fetched, err := New[tsModelBoth]().SetDB(db).Where("id", row.ID).First(ctx)
+		if err != nil {
+			t.Fatalf("First failed: %v", err)
+		}
+		if !fetched.CreatedAt.Equal(seeded) {
+			t.Errorf("expected created_at to be preserved as %v, got %v", seeded, fetched.CreatedAt)
+		}
+		if !fetched.UpdatedAt.Equal(touched) {
+			t.Errorf("expected updated_at to be preserved as %v, got %v", touched, fetched.UpdatedAt)
+		}
+	})
+
+	t.Run("CreateMany respects per-entity zero/preset state", func(t *testing.T) {
+		seeded := time.Date(2021, 6, 7, 8, 9, 10, 0, time.UTC)
+		before := time.Now()
+		rows := []*tsModelBoth{
+			{Name: "carol", Age: 25},                        // zero -> auto
+			{Name: "dave", Age: 35, CreatedAt: seeded},      // preserved
+			{Name: "eve", Age: 45},                          // zero -> auto
+		}
+		if err := New[tsModelBoth]().SetDB(db).CreateMany(ctx, rows); err != nil {
+			t.Fatalf("CreateMany failed: %v", err)
+		}
+
+		if rows[0].CreatedAt.IsZero() || rows[0].CreatedAt.Before(before.Truncate(time.Second)) {
+			t.Errorf("rows[0] created_at = %v, want >= %v", rows[0].CreatedAt, before)
+		}
+		if !rows[1].CreatedAt.Equal(seeded) {
+			t.Errorf("rows[1] created_at = %v, want preserved %v", rows[1].CreatedAt, seeded)
+		}
+		if rows[2].CreatedAt.IsZero() || rows[2].CreatedAt.Before(before.Truncate(time.Second)) {
+			t.Errorf("rows[2] created_at = %v, want >= %v", rows[2].CreatedAt, before)
+		}
+		for i, row := range rows {
+			if row.UpdatedAt.IsZero() {
+				t.Errorf("rows[%d] updated_at was not auto-set", i)
+			}
+		}
+	})
+}
+
+type defaultsModel struct {
+	ID     int `zorm:"primaryKey"`
+	Name   string
+	Status string
+	Score  int
+}
+
+func (defaultsModel) TableName() string { return "defaults_models" }
+
+func TestCreateOnly_LeavesOtherColumnsToDefaults(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE defaults_models (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT,
+			status TEXT NOT NULL DEFAULT 'pending',
+			score INTEGER NOT NULL DEFAULT 42
+		);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+	row := &defaultsModel{Name: "alice", Status: "ignored", Score: 0}
+	if err := New[defaultsModel]().SetDB(db).CreateOnly(ctx, row, "name"); err != nil {
+		t.Fatalf("CreateOnly failed: %v", err)
+	}
+	if row.ID == 0 {
+		t.Fatal("expected primary key to be hydrated")
+	}
+
+	fetched, err := New[defaultsModel]().SetDB(db).Find(ctx, row.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if fetched.Name != "alice" || fetched.Status != "pending" || fetched.Score != 42 {
+		t.Errorf("expected name=alice with DB defaults, got %+v", fetched)
+	}
+
+	if err := New[defaultsModel]().SetDB(db).CreateOnly(ctx, &defaultsModel{}, "missing"); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound for unknown column, got %v", err)
+	}
+	if err := New[defaultsModel]().SetDB(db).CreateOnly(ctx, &defaultsModel{}); err == nil {
+		t.Error("expected error when no columns are given")
+	}
+}
+
+func TestCreate_SQLiteStoresBoolsAsIntegers(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT, email TEXT, role TEXT,
+			active INTEGER,
+			created_at DATETIME, last_login DATETIME
+		);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, u := range []*ScopeUser{{Name: "alice", Active: true}, {Name: "bob"}, {Name: "carol", Active: true}} {
+		if err := New[ScopeUser]().SetDB(db).Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	var kind string
+	var stored int
+	if err := db.QueryRow(`SELECT typeof(active), active FROM users WHERE name = 'alice'`).Scan(&kind, &stored); err != nil {
+		t.Fatalf("raw select failed: %v", err)
+	}
+	if kind != "integer" || stored != 1 {
+		t.Errorf("expected active stored as integer 1, got %s %d", kind, stored)
+	}
+
+	active, err := New[ScopeUser]().SetDB(db).Where("active", true).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(active) != 2 || active[0].Name != "alice" || active[1].Name != "carol" || !active[0].Active {
+		t.Errorf("expected alice and carol read back as active, got %+v", active)
+	}
+
+	inactive, err := New[ScopeUser]().SetDB(db).Where(map[string]any{"active": false}).Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(inactive) != 1 || inactive[0].Name != "bob" || inactive[0].Active {
+		t.Errorf("expected only bob to be inactive, got %+v", inactive)
+	}
+
+	SetDialect(DialectMySQL)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	if _, args := New[ScopeUser]().Where("active", true).Print(); len(args) != 1 || args[0] != int64(1) {
+		t.Errorf("expected MySQL to bind true as 1, got %v", args)
+	}
+}
+
+type upsertModel struct {
+	ID    int `zorm:"primaryKey"`
+	Email string
+	Name  string
+}
+
+func (upsertModel) TableName() string { return "upsert_models" }
+
+func TestUpsertMany_InsertsAndUpdates(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	_, err = db.Exec(`
+		CREATE TABLE upsert_models (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			email TEXT NOT NULL UNIQUE,
+			name TEXT
+		);
+		INSERT INTO upsert_models (id, email, name) VALUES (1, 'a@example.com', 'old a'), (2, 'b@example.com', 'old b');
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+	rows := []*upsertModel{
+		{Email: "b@example.com", Name: "new b"},
+		{Email: "c@example.com", Name: "new c"},
+		{Email: "a@example.com", Name: "new a"},
+	}
+	if err := New[upsertModel]().SetDB(db).UpsertMany(ctx, rows, []string{"email"}); err != nil {
+		t.Fatalf("UpsertMany failed: %v", err)
+	}
+
+	if rows[0].ID != 2 || rows[2].ID != 1 {
+		t.Errorf("expected existing ids 2 and 1 scanned back, got %d and %d", rows[0].ID, rows[2].ID)
+	}
+	if rows[1].ID == 0 || rows[1].ID == 1 || rows[1].ID == 2 {
+		t.Errorf("expected a new id for the inserted row, got %d", rows[1].ID)
+	}
+
+	all, err := New[upsertModel]().SetDB(db).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(all))
+	}
+	for _, r := range all {
+		want := "new " + r.Email[:1]
+		if r.Name != want {
+			t.Errorf("row %d: expected name %q, got %q", r.ID, want, r.Name)
+		}
+	}
+
+	if err := New[upsertModel]().SetDB(db).UpsertMany(ctx, rows, []string{"missing"}); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound for unknown conflict column, got %v", err)
+	}
+	if err := New[upsertModel]().SetDB(db).UpsertMany(ctx, rows, nil); err == nil {
+		t.Error("expected error when no conflict columns are given")
+	}
+}
+
+func TestUpsertMany_Chunked(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	SetMaxPlaceholders(DialectSQLite, 6)
+	t.Cleanup(func() {
+		SetDialect(DialectAuto)
+		SetMaxPlaceholders(DialectSQLite, 0)
+	})
+
+	_, err = db.Exec(`
+		CREATE TABLE upsert_models (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			email TEXT NOT NULL UNIQUE,
+			name TEXT
+		);
+		INSERT INTO upsert_models (email, name) VALUES ('u0@example.com', 'old');
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+	rows := make([]*upsertModel, 7)
+	for i := range rows {
+		rows[i] = &upsertModel{Email: fmt.Sprintf("u%d@example.com", i), Name: "new"}
+	}
+	if err := New[upsertModel]().SetDB(db).UpsertMany(ctx, rows, []string{"email"}); err != nil {
+		t.Fatalf("UpsertMany failed: %v", err)
+	}
+
+	count, err := New[upsertModel]().SetDB(db).Where("name", "new").Count(ctx)
+	if err != nil {
+		t.Fatalf("Count failed: %v", err)
+	}
+	if count != 7 {
+		t.Errorf("expected 7 upserted rows, got %d", count)
+	}
+	for i, r := range rows {
+		if r.ID == 0 {
+			t.Errorf("row %d: expected id to be scanned back", i)
+		}
+	}
+}
+
+type upsertUUIDModel struct {
+	ID       int    `zorm:"primaryKey"`
+	PublicID string `zorm:"uuid"`
+	Email    string
+}
+
+func (upsertUUIDModel) TableName() string { return "upsert_uuid_models" }
+
+func TestUpsertMany_UUIDsAndKeyMatching(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	_, err = db.Exec(`
+		CREATE TABLE upsert_uuid_models (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			public_id TEXT NOT NULL,
+			email TEXT NOT NULL UNIQUE
+		);
+		INSERT INTO upsert_uuid_models (id, public_id, email) VALUES (5, 'old', 'a@example.com');
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+	rows := []*upsertUUIDModel{
+		{Email: "c@example.com"},
+		{Email: "a@example.com"},
+		{Email: "b@example.com", PublicID: "kept"},
+	}
+	if err := New[upsertUUIDModel]().SetDB(db).UpsertMany(ctx, rows, []string{"email"}); err != nil {
+		t.Fatalf("UpsertMany failed: %v", err)
+	}
+
+	if rows[1].ID != 5 {
+		t.Errorf("expected existing id 5 for a@example.com, got %d", rows[1].ID)
+	}
+	if rows[0].PublicID == "" || rows[2].PublicID != "kept" {
+		t.Errorf("expected a generated uuid and a kept one, got %q and %q", rows[0].PublicID, rows[2].PublicID)
+	}
+	for _, r := range rows {
+		stored, err := New[upsertUUIDModel]().SetDB(db).Find(ctx, r.ID)
+		if err != nil {
+			t.Fatalf("Find(%d) failed: %v", r.ID, err)
+		}
+		if stored.Email != r.Email || stored.PublicID != r.PublicID {
+			t.Errorf("id %d: expected %s/%s, got %s/%s", r.ID, r.Email, r.PublicID, stored.Email, stored.PublicID)
+		}
+	}
+}
+
+// insertCountingDriver wraps the sqlite3 driver and counts prepared INSERT
+// statements, so tests can assert how many statements a bulk write issued.
+type insertCountingDriver struct {
+	driver.Driver
+	inserts atomic.Int32
+}
+
+func (d *insertCountingDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &insertCountingConn{Conn: conn, d: d}, nil
+}
+
+type insertCountingConn struct {
+	driver.Conn
+	d *insertCountingDriver
+}
+
+func (c *insertCountingConn) Prepare(query string) (driver.Stmt, error) {
+	if strings.HasPrefix(query, "INSERT") {
+		c.d.inserts.Add(1)
+	}
+	return c.Conn.Prepare(query)
+}
+
+var (
+	countingDriver         *insertCountingDriver
+	registerCountingDriver sync.Once
+)
+
+func openInsertCountingDB(t *testing.T) (*sql.DB, *insertCountingDriver) {
+	t.Helper()
+	registerCountingDriver.Do(func() {
+		base, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			t.Fatalf("failed to open database: %v", err)
+		}
+		countingDriver = &insertCountingDriver{Driver: base.Driver()}
+		base.Close()
+		sql.Register("sqlite3_insert_counting", countingDriver)
+	})
+	db, err := sql.Open("sqlite3_insert_counting", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	// A single connection keeps the in-memory database shared.
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	countingDriver.inserts.Store(0)
+	return db, countingDriver
+}
+
+func TestBatchSize_CreateManyStatementCount(t *testing.T) {
+	db, counter := openInsertCountingDB(t)
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	if _, err := db.Exec(`CREATE TABLE defaults_models (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT,
+		status TEXT,
+		score INTEGER
+	)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	rows := make([]*defaultsModel, 7)
+	for i := range rows {
+		rows[i] = &defaultsModel{Name: fmt.Sprintf("row %d", i)}
+	}
+	if err := New[defaultsModel]().SetDB(db).BatchSize(3).CreateMany(context.Background(), rows); err != nil {
+		t.Fatalf("CreateMany failed: %v", err)
+	}
+
+	if got := counter.inserts.Load(); got != 3 {
+		t.Errorf("expected 3 INSERT statements for 7 rows in batches of 3, got %d", got)
+	}
+	for i, r := range rows {
+		if r.ID != i+1 {
+			t.Errorf("row %d: expected id %d, got %d", i, i+1, r.ID)
+		}
+	}
+}
+
+func TestCreate_LastInsertIDWithoutReturning(t *testing.T) {
 	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
 	}
 	defer db.Close()
 
-	_, err = db.Exec(`
-		CREATE TABLE ts_models_both (
-			id INTEGER PRIMARY KEY,
-			name TEXT,
-			age INTEGER,
-			updated_at DATETIME,
-			created_at DATETIME
-		);
-		INSERT INTO ts_models_both (id, name, age, updated_at, created_at) VALUES
-			(1, 'orig', 30, '2020-01-01 00:00:00', '2020-01-01 00:00:00');
-	`)
-	if err != nil {
-		t.Fatalf("failed to setup DB: %v", err)
+	if _, err := db.Exec(`CREATE TABLE defaults_models (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT,
+		status TEXT,
+		score INTEGER
+	)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
 	}
 
+	// The MySQL dialect keeps `?` placeholders, which SQLite also accepts,
+	// and has no RETURNING, so keys must come back via LastInsertId.
 	ctx := context.Background()
+	model := func() *Model[defaultsModel] { return New[defaultsModel]().SetDB(db).SetDialect(DialectMySQL) }
 
-	// Load the existing row so CreatedAt is non-zero and UpdatedAt reflects
-	// the seeded 2020 value.
-	row, err := New[tsModelBoth]().SetDB(db).Where("id", 1).First(ctx)
-	if err != nil {
-		t.Fatalf("First failed: %v", err)
+	rec, stop := RecordQueries(ctx)
+	first, second := &defaultsModel{Name: "first"}, &defaultsModel{Name: "second"}
+	for _, row := range []*defaultsModel{first, second} {
+		if err := model().Create(ctx, row); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		defer ClearOriginals(row)
+	}
+	stop()
+	if first.ID != 1 || second.ID != 2 {
+		t.Errorf("expected ids 1, 2, got %d, %d", first.ID, second.ID)
+	}
+	for _, q := range rec.Queries() {
+		if strings.Contains(q.Query, "RETURNING") || strings.Contains(q.Query, "$1") {
+			t.Errorf("expected a MySQL-style INSERT, got %s", q.Query)
+		}
 	}
-	seededCreatedAt := row.CreatedAt
-	seededUpdatedAt := row.UpdatedAt
-
-	// Mutate a non-timestamp field; do NOT touch UpdatedAt.
-	row.Name = "changed"
 
-	beforeUpdate := time.Now()
-	if err := New[tsModelBoth]().SetDB(db).Update(ctx, row); err != nil {
-		t.Fatalf("Update failed: %v", err)
+	bulk := []*defaultsModel{{Name: "third"}, {Name: "fourth"}}
+	if err := model().BulkInsert(ctx, bulk); err != nil {
+		t.Fatalf("BulkInsert failed: %v", err)
 	}
+	if bulk[0].ID != 3 || bulk[1].ID != 4 {
+		t.Errorf("expected BulkInsert ids 3, 4, got %d, %d", bulk[0].ID, bulk[1].ID)
+	}
+}
 
-	// Re-fetch and assert updated_at advanced to ~time.Now() and is not the
-	// zero time.Time value.
-	after, err := New[tsModelBoth]().SetDB(db).Where("id", 1).First(ctx)
+func TestCreateMany_LastInsertIDWithoutReturning(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
-		t.Fatalf("re-fetch failed: %v", err)
+		t.Fatalf("failed to open database: %v", err)
 	}
+	defer db.Close()
 
-	if after.UpdatedAt.IsZero() {
-		t.Fatalf("expected updated_at to be auto-set, got zero value")
+	if _, err := db.Exec(`CREATE TABLE defaults_models (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT,
+		status TEXT,
+		score INTEGER
+	)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
 	}
-	if after.UpdatedAt.Equal(seededUpdatedAt) {
-		t.Fatalf("expected updated_at to change from seeded %v, still %v", seededUpdatedAt, after.UpdatedAt)
+
+	// Row "explicit" supplies its own key; the others must be backfilled
+	// around it, including the one generated after it.
+	ctx := context.Background()
+	rows := []*defaultsModel{{Name: "a"}, {Name: "b"}, {ID: 10, Name: "explicit"}, {Name: "c"}}
+	if err := New[defaultsModel]().SetDB(db).SetDialect(DialectMySQL).CreateMany(ctx, rows); err != nil {
+		t.Fatalf("CreateMany failed: %v", err)
+	}
+
+	for _, want := range []struct {
+		id   int
+		name string
+	}{{1, "a"}, {2, "b"}, {10, "explicit"}, {11, "c"}} {
+		var row *defaultsModel
+		for _, r := range rows {
+			if r.Name == want.name {
+				row = r
+			}
+		}
+		if row.ID != want.id {
+			t.Errorf("%s: expected id %d, got %d", want.name, want.id, row.ID)
+		}
+		var stored string
+		if err := db.QueryRow(`SELECT name FROM defaults_models WHERE id = ?`, row.ID).Scan(&stored); err != nil || stored != want.name {
+			t.Errorf("%s: expected row %d to hold it, got %q (%v)", want.name, row.ID, stored, err)
+		}
 	}
-	// Allow a small clock skew window; updated_at should be at or after
-	// beforeUpdate (truncated to second since sqlite DATETIME loses sub-second
-	// precision depending on driver formatting).
-	if after.UpdatedAt.Before(beforeUpdate.Truncate(time.Second)) {
-		t.Errorf("expected updated_at >= %v, got %v", beforeUpdate, after.UpdatedAt)
+}
+
+func TestCreateMany_ChunksUnderParameterLimit(t *testing.T) {
+	db, counter := openInsertCountingDB(t)
+
+	// Emulate a SQLite build with the old 999-variable limit: one INSERT of
+	// 20k rows would need 60k parameters.
+	SetDialect(DialectSQLite)
+	SetMaxPlaceholders(DialectSQLite, 999)
+	t.Cleanup(func() {
+		SetDialect(DialectAuto)
+		SetMaxPlaceholders(DialectSQLite, 0)
+	})
+
+	if _, err := db.Exec(`CREATE TABLE defaults_models (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT,
+		status TEXT,
+		score INTEGER
+	)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
 	}
 
-	// Sanity: created_at must not be overwritten by Update.
-	if !after.CreatedAt.Equal(seededCreatedAt) {
-		t.Errorf("created_at should be unchanged by Update; was %v, now %v", seededCreatedAt, after.CreatedAt)
+	const total = 20000
+	rows := make([]*defaultsModel, total)
+	for i := range rows {
+		rows[i] = &defaultsModel{Name: "r", Score: i}
+	}
+	if err := New[defaultsModel]().SetDB(db).CreateMany(context.Background(), rows); err != nil {
+		t.Fatalf("CreateMany failed: %v", err)
 	}
 
-	// Sanity: the non-timestamp mutation was persisted.
-	if after.Name != "changed" {
-		t.Errorf("expected name 'changed', got %q", after.Name)
+	// 999 / 3 columns = 333 rows per INSERT.
+	if got, want := counter.inserts.Load(), int32((total+332)/333); got != want {
+		t.Errorf("expected %d INSERT statements, got %d", want, got)
+	}
+	for i, r := range rows {
+		if r.ID != i+1 {
+			t.Fatalf("row %d: expected id %d, got %d", i, i+1, r.ID)
+		}
+	}
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM defaults_models`).Scan(&count); err != nil || count != total {
+		t.Errorf("expected %d stored rows, got %d (%v)", total, count, err)
 	}
 }
 
-func TestCreate_AutoSetsCreatedAt(t *testing.T) {
+func TestReplicate_CreatesNewRecord(t *testing.T) {
 	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
@@ -566,8 +1463,8 @@ func TestCreate_AutoSetsCreatedAt(t *testing.T) {
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			name TEXT,
 			age INTEGER,
-			updated_at DATETIME,
-			created_at DATETIME
+			created_at DATETIME,
+			updated_at DATETIME
 		);
 	`)
 	if err != nil {
@@ -575,68 +1472,41 @@ func TestCreate_AutoSetsCreatedAt(t *testing.T) {
 	}
 
 	ctx := context.Background()
+	m := New[tsModelBoth]().SetDB(db)
+	original := &tsModelBoth{Name: "alice", Age: 30}
+	if err := m.Create(ctx, original); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
 
-	t.Run("zero CreatedAt is auto-populated", func(t *testing.T) {
-		before := time.Now()
-		row := &tsModelBoth{Name: "alice", Age: 30}
-		if err := New[tsModelBoth]().SetDB(db).Create(ctx, row); err != nil {
-			t.Fatalf("Create failed: %v", err)
-		}
-
-		if row.CreatedAt.IsZero() {
-			t.Fatalf("expected created_at to be auto-set on entity, got zero")
-		}
-		if row.CreatedAt.Before(before.Truncate(time.Second)) {
-			t.Errorf("expected created_at >= %v, got %v", before, row.CreatedAt)
-		}
-
-		fetched, err := New[tsModelBoth]().SetDB(db).Where("id", row.ID).First(ctx)
-		if err != nil {
-			t.Fatalf("First failed: %v", err)
-		}
-		if fetched.CreatedAt.IsZero() {
-			t.Fatalf("expected created_at persisted to DB, got zero")
-		}
-	})
-
-	t.Run("pre-set CreatedAt is preserved", func(t *testing.T) {
-		seeded := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
-		row := &tsModelBoth{Name: "bob", Age: 40, CreatedAt: seeded}
-		if err := New[tsModelBoth]().SetDB(db).Create(ctx, row); err != nil {
-			t.Fatalf("Create failed: %v", err)
-		}
-
-		fetched, err := New[tsModelBoth]().SetDB(db).Where("id", row.ID).First(ctx)
-		if err != nil {
-			t.Fatalf("First failed: %v", err)
-		}
-		if !fetched.CreatedAt.Equal(seeded) {
-			t.Errorf("expected created_at to be preserved as %v, got %v", seeded, fetched.CreatedAt)
-		}
-	})
+	replica := m.Replicate(original)
+	if replica.ID != 0 {
+		t.Errorf("expected primary key to be cleared, got %d", replica.ID)
+	}
+	if !replica.CreatedAt.IsZero() || !replica.UpdatedAt.IsZero() {
+		t.Errorf("expected timestamps to be cleared, got %v / %v", replica.CreatedAt, replica.UpdatedAt)
+	}
+	if replica.Name != "alice" || replica.Age != 30 {
+		t.Errorf("expected columns to be copied, got %+v", replica)
+	}
 
-	t.Run("CreateMany respects per-entity zero/preset state", func(t *testing.T) {
-		seeded := time.Date(2021, 6, 7, 8, 9, 10, 0, time.UTC)
-		before := time.Now()
-		rows := []*tsModelBoth{
-			{Name: "carol", Age: 25},                        // zero -> auto
-			{Name: "dave", Age: 35, CreatedAt: seeded},      // preserved
-			{Name: "eve", Age: 45},                          // zero -> auto
-		}
-		if err := New[tsModelBoth]().SetDB(db).CreateMany(ctx, rows); err != nil {
-			t.Fatalf("CreateMany failed: %v", err)
-		}
+	if err := m.Create(ctx, replica); err != nil {
+		t.Fatalf("Create replica failed: %v", err)
+	}
+	if replica.ID == 0 || replica.ID == original.ID {
+		t.Errorf("expected replica to get a new id, got %d (original %d)", replica.ID, original.ID)
+	}
+	count, err := New[tsModelBoth]().SetDB(db).Where("name", "alice").Count(ctx)
+	if err != nil {
+		t.Fatalf("Count failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 rows after replicating, got %d", count)
+	}
 
-		if rows[0].CreatedAt.IsZero() || rows[0].CreatedAt.Before(before.Truncate(time.Second)) {
-			t.Errorf("rows[0] created_at = %v, want >= %v", rows[0].CreatedAt, before)
-		}
-		if !rows[1].CreatedAt.Equal(seeded) {
-			t.Errorf("rows[1] created_at = %v, want preserved %v", rows[1].CreatedAt, seeded)
-		}
-		if rows[2].CreatedAt.IsZero() || rows[2].CreatedAt.Before(before.Truncate(time.Second)) {
-			t.Errorf("rows[2] created_at = %v, want >= %v", rows[2].CreatedAt, before)
-		}
-	})
+	partial := m.Replicate(original, "age")
+	if partial.Name != "alice" || partial.Age != 0 {
+		t.Errorf("expected age to be excluded, got %+v", partial)
+	}
 }
 
 func TestUpdateManyByKey_Chunking(t *testing.T) {
@@ -1501,6 +2371,46 @@ func TestCount_WithGroupByAndHaving(t *testing.T) {
 	}
 }
 
+// TestCount_MatchesGet verifies that Count agrees with the number of rows Get
+// returns, with and without grouping.
+func TestCount_MatchesGet(t *testing.T) {
+	db := setupExDBDuplicates(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		query func() *Model[ExModel]
+		want  int
+	}{
+		{"plain", func() *Model[ExModel] { return New[ExModel]() }, 5},
+		{"where", func() *Model[ExModel] { return New[ExModel]().Where("value", ">", 15) }, 4},
+		{"group by", func() *Model[ExModel] { return New[ExModel]().Select("name").GroupBy("name") }, 3},
+		{"group by with where", func() *Model[ExModel] {
+			return New[ExModel]().Select("name").Where("value", ">", 15).GroupBy("name")
+		}, 3},
+		{"having on a select alias", func() *Model[ExModel] {
+			return New[ExModel]().Select("name").SelectCountFilter("big", "value > ?", 25).GroupBy("name").Having("big > ?", 0)
+		}, 2},
+		{"distinct without select", func() *Model[ExModel] { return New[ExModel]().Distinct() }, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := tt.query().SetDB(db).Get(ctx)
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			count, err := tt.query().SetDB(db).Count(ctx)
+			if err != nil {
+				t.Fatalf("Count failed: %v", err)
+			}
+			if len(rows) != tt.want || count != int64(len(rows)) {
+				t.Errorf("expected %d rows and a matching count, got %d rows and count %d", tt.want, len(rows), count)
+			}
+		})
+	}
+}
+
 // TestPaginate_WithGroupBy verifies that Paginate correctly computes Total
 // as the number of distinct groups when GroupBy is applied.
 func TestPaginate_WithGroupBy(t *testing.T) {
@@ -1525,3 +2435,180 @@ func TestPaginate_WithGroupBy(t *testing.T) {
 		t.Errorf("expected 3 data items, got %d", len(result.Data))
 	}
 }
+
+func TestExecutor_IncrementDecrement(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	values := func() string {
+		got, err := New[ExModel]().SetDB(db).OrderBy("id", "ASC").Get(ctx)
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		var out []int
+		for _, m := range got {
+			out = append(out, m.Value)
+		}
+		return fmt.Sprint(out)
+	}
+
+	n, err := New[ExModel]().SetDB(db).Where("name", "A").Increment(ctx, "value")
+	if err != nil || n != 1 {
+		t.Fatalf("Increment: expected 1 row, got %d (%v)", n, err)
+	}
+	if got := values(); got != "[11 20 30]" {
+		t.Errorf("expected [11 20 30] after default increment, got %s", got)
+	}
+
+	n, err = New[ExModel]().SetDB(db).Where("value", ">=", 20).Decrement(ctx, "value", 5)
+	if err != nil || n != 2 {
+		t.Fatalf("Decrement: expected 2 rows, got %d (%v)", n, err)
+	}
+	if got := values(); got != "[11 15 25]" {
+		t.Errorf("expected [11 15 25] after decrement, got %s", got)
+	}
+
+	n, err = New[ExModel]().SetDB(db).Where("id", 3).IncrementWith(ctx, "value", 100, map[string]any{"name": "bumped"})
+	if err != nil || n != 1 {
+		t.Fatalf("IncrementWith: expected 1 row, got %d (%v)", n, err)
+	}
+	row, err := New[ExModel]().SetDB(db).Find(ctx, 3)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if row.Value != 125 || row.Name != "bumped" {
+		t.Errorf("expected value 125 and name bumped, got %+v", row)
+	}
+
+	if _, err := New[ExModel]().SetDB(db).Increment(ctx, "value; DROP TABLE ex_models"); err == nil {
+		t.Error("expected error for invalid column")
+	}
+	if _, err := New[ExModel]().SetDB(db).Increment(ctx, "value", "1; --"); !errors.Is(err, ErrInvalidModel) {
+		t.Errorf("expected ErrInvalidModel for non-numeric amount, got %v", err)
+	}
+	if _, err := New[ExModel]().SetDB(db).IncrementWith(ctx, "value", 1, map[string]any{"value": 3}); !errors.Is(err, ErrInvalidModel) {
+		t.Errorf("expected ErrInvalidModel when the column is also assigned, got %v", err)
+	}
+}
+
+func TestExecutor_UpdateManyResult(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	n, err := New[ExModel]().SetDB(db).Where("value", ">=", 20).UpdateManyResult(ctx, map[string]any{"name": "big"})
+	if err != nil || n != 2 {
+		t.Fatalf("matching: expected 2 rows, got %d (%v)", n, err)
+	}
+
+	n, err = New[ExModel]().SetDB(db).Where("name", "missing").UpdateManyResult(ctx, map[string]any{"value": 0})
+	if err != nil || n != 0 {
+		t.Fatalf("non-matching: expected 0 rows, got %d (%v)", n, err)
+	}
+
+	n, err = New[ExModel]().SetDB(db).UpdateManyResult(ctx, map[string]any{"value": 1})
+	if err != nil || n != 3 {
+		t.Fatalf("empty WHERE: expected 3 rows, got %d (%v)", n, err)
+	}
+
+	if n, err := New[ExModel]().SetDB(db).UpdateManyResult(ctx, nil); err != nil || n != 0 {
+		t.Errorf("no values: expected 0 rows and no error, got %d (%v)", n, err)
+	}
+}
+
+func TestExecutor_UpdateManyRawExpr(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	rec, stop := RecordQueries(ctx)
+	n, err := New[ExModel]().SetDB(db).Where("name", "B").UpdateManyResult(ctx, map[string]any{
+		"value": Raw("value * ? + ?", 2, 1),
+		"name":  "doubled",
+	})
+	stop()
+	if err != nil || n != 1 {
+		t.Fatalf("UpdateManyResult: expected 1 row, got %d (%v)", n, err)
+	}
+	if err := rec.AssertContains("name = $1, value = value * $2 + $3"); err != nil {
+		t.Error(err)
+	}
+
+	row, err := New[ExModel]().SetDB(db).Find(ctx, 2)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if row.Value != 41 || row.Name != "doubled" {
+		t.Errorf("expected value 41 and name doubled, got %+v", row)
+	}
+
+	if _, err := New[ExModel]().SetDB(db).Where("id", 3).IncrementWith(ctx, "value", 1, map[string]any{"name": Raw("name || ?", "!")}); err != nil {
+		t.Fatalf("IncrementWith raw extra: %v", err)
+	}
+	if row, _ := New[ExModel]().SetDB(db).Find(ctx, 3); row == nil || row.Value != 31 || row.Name != "C!" {
+		t.Errorf("expected value 31 and name C!, got %+v", row)
+	}
+
+	if err := New[ExModel]().SetDB(db).UpdateMany(ctx, map[string]any{"value": Raw("value + ?")}); !errors.Is(err, ErrInvalidModel) {
+		t.Errorf("expected ErrInvalidModel for unbalanced placeholders, got %v", err)
+	}
+	if err := New[ExModel]().SetDB(db).UpdateMany(ctx, map[string]any{"value": Raw(" ")}); !errors.Is(err, ErrInvalidModel) {
+		t.Errorf("expected ErrInvalidModel for an empty expression, got %v", err)
+	}
+}
+
+func TestExecutor_DeleteManyResult(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	n, err := New[ExModel]().SetDB(db).Where("name", "missing").DeleteManyResult(ctx)
+	if err != nil || n != 0 {
+		t.Fatalf("non-matching: expected 0 rows, got %d (%v)", n, err)
+	}
+
+	n, err = New[ExModel]().SetDB(db).Where("value", "<", 30).DeleteManyResult(ctx)
+	if err != nil || n != 2 {
+		t.Fatalf("matching: expected 2 rows, got %d (%v)", n, err)
+	}
+
+	if _, err := New[ExModel]().SetDB(db).DeleteManyResult(ctx); !errors.Is(err, ErrInvalidModel) {
+		t.Errorf("empty WHERE: expected ErrInvalidModel, got %v", err)
+	}
+	if count, _ := New[ExModel]().SetDB(db).Count(ctx); count != 1 {
+		t.Errorf("expected 1 remaining row, got %d", count)
+	}
+}
+
+func TestExecutor_IncrementTouchesUpdatedAt(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE ts_models_both (id INTEGER PRIMARY KEY, name TEXT, age INTEGER, updated_at DATETIME, created_at DATETIME);
+		INSERT INTO ts_models_both (id, name, age, updated_at, created_at) VALUES
+			(1, 'a', 30, '2020-01-01 00:00:00', '2020-01-01 00:00:00');
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := New[tsModelBoth]().SetDB(db).Where("id", 1).Increment(ctx, "age"); err != nil {
+		t.Fatalf("Increment failed: %v", err)
+	}
+	row, err := New[tsModelBoth]().SetDB(db).Find(ctx, 1)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if row.Age != 31 {
+		t.Errorf("expected age 31, got %d", row.Age)
+	}
+	if row.UpdatedAt.Year() == 2020 {
+		t.Errorf("expected updated_at to be refreshed, got %v", row.UpdatedAt)
+	}
+}
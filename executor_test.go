@@ -818,3 +818,72 @@ func TestModelInfo_MultipleRelationsExcluded(t *testing.T) {
 		})
 	}
 }
+
+// TestBatchSize_ChunkSize verifies BatchSize overrides the derived chunk size
+// but never exceeds the dialect's placeholder limit
+func TestBatchSize_ChunkSize(t *testing.T) {
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() {
+		SetDialect(DialectAuto)
+		SetMaxPlaceholders(DialectSQLite, 0)
+	})
+
+	if got := New[TestModel]().BatchSize(50).insertChunkSize(10); got != 50 {
+		t.Errorf("expected BatchSize(50) to give 50 rows per chunk, got %d", got)
+	}
+	if got := New[TestModel]().BatchSize(10000).insertChunkSize(10); got != 3276 {
+		t.Errorf("expected 10000-row batch capped at 3276 by the SQLite limit, got %d", got)
+	}
+
+	SetMaxPlaceholders(DialectSQLite, 999)
+	if got := New[TestModel]().BatchSize(200).insertChunkSize(10); got != 99 {
+		t.Errorf("expected batch capped at 99 by SetMaxPlaceholders, got %d", got)
+	}
+
+	m := New[TestModel]().BatchSize(0)
+	if m.buildErr == nil {
+		t.Error("expected buildErr for non-positive batch size")
+	}
+	if err := m.CreateMany(context.Background(), []*TestModel{{}}); err == nil {
+		t.Error("expected CreateMany to return the BatchSize error")
+	}
+}
+
+// TestBulkInsertChunkSize_FromConfiguredLimit verifies bulk insert chunk sizes
+// follow the dialect's placeholder limit, including SetMaxPlaceholders overrides
+func TestBulkInsertChunkSize_FromConfiguredLimit(t *testing.T) {
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() {
+		SetDialect(DialectAuto)
+		SetMaxPlaceholders(DialectSQLite, 0)
+	})
+
+	m := New[TestModel]()
+	if got := m.maxParams(); got != 32766 {
+		t.Fatalf("expected default SQLite limit 32766, got %d", got)
+	}
+	if got := bulkInsertChunkSize(m.maxParams(), 10); got != 3276 {
+		t.Errorf("expected 3276 rows per chunk for 10 columns, got %d", got)
+	}
+
+	SetMaxPlaceholders(DialectSQLite, 999)
+	if got := bulkInsertChunkSize(m.maxParams(), 10); got != 99 {
+		t.Errorf("expected 99 rows per chunk under a 999 limit, got %d", got)
+	}
+	if got := bulkInsertChunkSize(m.maxParams(), 2000); got != 1 {
+		t.Errorf("expected chunk size floor of 1, got %d", got)
+	}
+
+	SetDialect(DialectPostgres)
+	if got := bulkInsertChunkSize(m.maxParams(), 3); got != bulkInsertMaxRowsPerStmt {
+		t.Errorf("expected chunk size capped at %d, got %d", bulkInsertMaxRowsPerStmt, got)
+	}
+
+	SetMaxPlaceholders(DialectSQLite, 0)
+	if got := DialectSQLite.MaxPlaceholders(); got != 32766 {
+		t.Errorf("expected override removal to restore 32766, got %d", got)
+	}
+	if got := DialectAuto.MaxPlaceholders(); got != maxInArgs {
+		t.Errorf("expected DialectAuto to use the PostgreSQL limit, got %d", got)
+	}
+}
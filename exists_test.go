@@ -178,3 +178,140 @@ func TestExists_Error(t *testing.T) {
 		t.Error("expected error with cache enabled due to closed db, got nil")
 	}
 }
+
+// TestDoesntExist verifies DoesntExist negates Exists, keeps the model's
+// limit, offset and order untouched, and sees uncommitted rows inside WithTx.
+func TestDoesntExist(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`
+		CREATE TABLE test_models (id INTEGER PRIMARY KEY, name TEXT, user_age INTEGER, embedded_field TEXT);
+		INSERT INTO test_models (id, name) VALUES (1, 'Alice');
+	`)
+	if err != nil {
+		t.Fatalf("failed to set up table: %v", err)
+	}
+
+	ctx := context.Background()
+
+	m := New[TestModel]().SetDB(db).Where("name", "Alice").OrderBy("id", "DESC").Limit(5).Offset(2)
+	missing, err := m.DoesntExist(ctx)
+	if err != nil || missing {
+		t.Errorf("expected Alice to exist, got missing=%v err=%v", missing, err)
+	}
+	if m.limit != 5 || m.offset != 2 || len(m.orderBys) != 1 {
+		t.Errorf("expected limit/offset/order preserved, got %d/%d/%v", m.limit, m.offset, m.orderBys)
+	}
+
+	missing, err = New[TestModel]().SetDB(db).Where("name", "Bob").DoesntExist(ctx)
+	if err != nil || !missing {
+		t.Errorf("expected Bob to be missing, got missing=%v err=%v", missing, err)
+	}
+
+	// Inside a transaction the uncommitted row is visible
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`INSERT INTO test_models (id, name) VALUES (2, 'Bob')`); err != nil {
+		t.Fatal(err)
+	}
+	exists, err := New[TestModel]().WithTx(&Tx{Tx: tx}).Where("name", "Bob").Exists(ctx)
+	if err != nil || !exists {
+		t.Errorf("expected Bob to exist within the transaction, got exists=%v err=%v", exists, err)
+	}
+}
+
+// TestUnique verifies Unique detects existing values and ignores the row
+// passed as the exception id.
+func TestUnique(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE test_models (id INTEGER PRIMARY KEY, name TEXT, user_age INTEGER, embedded_field TEXT);
+		INSERT INTO test_models (id, name, user_age) VALUES (1, 'Alice', 30), (2, 'Bob', 40);
+	`)
+	if err != nil {
+		t.Fatalf("failed to set up table: %v", err)
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		value    string
+		exceptID []any
+		want     bool
+	}{
+		{"taken", "Alice", nil, false},
+		{"free", "Carol", nil, true},
+		{"own row excluded", "Alice", []any{1}, true},
+		{"other row still counts", "Alice", []any{2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unique, err := New[TestModel]().SetDB(db).Unique(ctx, "name", tt.value, tt.exceptID...)
+			if err != nil {
+				t.Fatalf("Unique failed: %v", err)
+			}
+			if unique != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, unique)
+			}
+		})
+	}
+
+	if _, err := New[TestModel]().SetDB(db).Unique(ctx, "name; DROP TABLE test_models", "x"); err == nil {
+		t.Error("expected error for invalid column")
+	}
+}
+
+// TestExists_BuildErrAndJoins verifies a rejected condition surfaces as an
+// error instead of checking the unfiltered table, and that joined columns
+// can be filtered on.
+func TestExists_BuildErrAndJoins(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE test_models (id INTEGER PRIMARY KEY, name TEXT, user_age INTEGER, embedded_field TEXT);
+		CREATE TABLE badges (id INTEGER PRIMARY KEY, test_model_id INTEGER, label TEXT);
+		INSERT INTO test_models (id, name) VALUES (1, 'Alice');
+		INSERT INTO badges (test_model_id, label) VALUES (1, 'gold');
+	`)
+	if err != nil {
+		t.Fatalf("failed to set up tables: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := New[TestModel]().SetDB(db).WhereIn("id; DROP TABLE test_models", []any{1}).Exists(ctx); err == nil {
+		t.Error("expected the invalid column error from Exists")
+	}
+	if _, err := New[TestModel]().SetDB(db).WhereIn("id; DROP TABLE test_models", []any{1}).DoesntExist(ctx); err == nil {
+		t.Error("expected the invalid column error from DoesntExist")
+	}
+
+	for label, want := range map[string]bool{"gold": true, "silver": false} {
+		exists, err := New[TestModel]().SetDB(db).
+			Join("badges", "badges.test_model_id", "=", "test_models.id").
+			Where("badges.label", label).
+			Exists(ctx)
+		if err != nil {
+			t.Fatalf("Exists with join failed: %v", err)
+		}
+		if exists != want {
+			t.Errorf("label %s: expected exists=%v, got %v", label, want, exists)
+		}
+	}
+}
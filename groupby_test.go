@@ -1,6 +1,7 @@
 package zorm
 
 import (
+	"context"
 	"strings"
 	"testing"
 )
@@ -59,3 +60,30 @@ func TestGroupByMixed(t *testing.T) {
 		t.Errorf("expected query %q, got %q", expected, query)
 	}
 }
+
+func TestValueCounts(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	counts, err := New[PluckUser]().SetDB(db).ValueCounts(ctx, "role")
+	if err != nil {
+		t.Fatalf("ValueCounts failed: %v", err)
+	}
+	if len(counts) != 2 || counts["admin"] != 2 || counts["user"] != 2 {
+		t.Errorf("expected admin=2 user=2, got %v", counts)
+	}
+
+	// Existing where clauses narrow the distribution
+	counts, err = New[PluckUser]().SetDB(db).Where("active", 1).ValueCounts(ctx, "role")
+	if err != nil {
+		t.Fatalf("ValueCounts failed: %v", err)
+	}
+	if len(counts) != 2 || counts["admin"] != 2 || counts["user"] != 1 {
+		t.Errorf("expected admin=2 user=1, got %v", counts)
+	}
+
+	if _, err := New[PluckUser]().SetDB(db).ValueCounts(ctx, "role; DROP TABLE users"); err == nil {
+		t.Error("expected error for invalid column name")
+	}
+}
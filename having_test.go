@@ -63,3 +63,48 @@ func TestHaving_Multiple(t *testing.T) {
 		t.Errorf("expected query %q, got %q", expected, query)
 	}
 }
+
+func TestHaving_OrHavingWithCTEAndWhere(t *testing.T) {
+	cte := New[TestModel]().Select("id", "city").Where("temp", ">", 30)
+
+	// Having is called before Where: its args must still bind after WHERE's.
+	query, args := New[TestModel]().
+		WithCTE("hot", cte).
+		Select("city", "COUNT(*)").
+		Having("COUNT(*) > ?", 5).
+		OrHaving("MAX(temp) >= ?", 40).
+		Where("region", "north").
+		GroupBy("city").
+		buildSelectQuery()
+
+	expected := "WITH hot AS (SELECT id, city FROM test_models WHERE 1=1  AND temp > ?) " +
+		"SELECT city, COUNT(*) FROM test_models WHERE 1=1  AND region = ? GROUP BY city " +
+		"HAVING COUNT(*) > ? OR MAX(temp) >= ?"
+	if strings.TrimSpace(query) != expected {
+		t.Errorf("expected query %q, got %q", expected, query)
+	}
+	if len(args) != 4 || args[0] != 30 || args[1] != "north" || args[2] != 5 || args[3] != 40 {
+		t.Errorf("expected args [30 north 5 40], got %v", args)
+	}
+}
+
+func TestHaving_Raw(t *testing.T) {
+	query, args := New[TestModel]().
+		Select("city").
+		GroupBy("city").
+		HavingRaw("COUNT(CASE WHEN temp > ? THEN 1 END) > ?", 30, 2).
+		OrHaving("COUNT(*) = 1").
+		buildSelectQuery()
+
+	expected := "SELECT city FROM test_models GROUP BY city HAVING COUNT(CASE WHEN temp > ? THEN 1 END) > ? OR COUNT(*) = 1"
+	if strings.TrimSpace(query) != expected {
+		t.Errorf("expected query %q, got %q", expected, query)
+	}
+	if len(args) != 2 || args[0] != 30 || args[1] != 2 {
+		t.Errorf("expected args [30 2], got %v", args)
+	}
+
+	if m := New[TestModel]().HavingRaw("COUNT(*) > ?"); m.buildErr == nil {
+		t.Error("expected buildErr for missing args")
+	}
+}
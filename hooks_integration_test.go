@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
 	"testing"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -1339,3 +1340,98 @@ func TestTxHook_AutoTxRollsBackOnPanic(t *testing.T) {
 		t.Fatalf("expected 0 rows after panic+rollback; got %d (auto-tx leaked the insert)", count)
 	}
 }
+
+// ---- BeforeSave / AfterSave ----
+
+// HookSaveItem implements the save hooks alongside the create and update
+// hooks and records the order they fire in.
+type HookSaveItem struct {
+	ID    int `zorm:"primaryKey"`
+	Name  string
+	Value int
+	Calls []string `zorm:"-"`
+}
+
+func (h *HookSaveItem) TableName() string { return "hook_items" }
+
+func (h *HookSaveItem) BeforeSave(ctx context.Context) error {
+	if h.Name == "" {
+		return errors.New("name is required")
+	}
+	h.Calls = append(h.Calls, "BeforeSave")
+	return nil
+}
+
+func (h *HookSaveItem) AfterSave(ctx context.Context) error {
+	h.Calls = append(h.Calls, "AfterSave")
+	return nil
+}
+
+func (h *HookSaveItem) BeforeCreate(ctx context.Context) error {
+	h.Calls = append(h.Calls, "BeforeCreate")
+	return nil
+}
+
+func (h *HookSaveItem) AfterCreate(ctx context.Context) error {
+	h.Calls = append(h.Calls, "AfterCreate")
+	return nil
+}
+
+func (h *HookSaveItem) BeforeUpdate(ctx context.Context) error {
+	h.Calls = append(h.Calls, "BeforeUpdate")
+	return nil
+}
+
+func (h *HookSaveItem) AfterUpdate(ctx context.Context) error {
+	h.Calls = append(h.Calls, "AfterUpdate")
+	return nil
+}
+
+func TestHook_SaveHooks_WrapCreateAndUpdate(t *testing.T) {
+	db := setupHooksDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	item := &HookSaveItem{Name: "test", Value: 1}
+
+	if err := New[HookSaveItem]().SetDB(db).Create(ctx, item); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	expected := []string{"BeforeSave", "BeforeCreate", "AfterCreate", "AfterSave"}
+	if !slices.Equal(item.Calls, expected) {
+		t.Errorf("Create: expected hooks %v, got %v", expected, item.Calls)
+	}
+
+	item.Calls = nil
+	item.Value = 2
+	if err := New[HookSaveItem]().SetDB(db).Update(ctx, item); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	expected = []string{"BeforeSave", "BeforeUpdate", "AfterUpdate", "AfterSave"}
+	if !slices.Equal(item.Calls, expected) {
+		t.Errorf("Update: expected hooks %v, got %v", expected, item.Calls)
+	}
+}
+
+func TestHook_BeforeSave_AbortsCreate(t *testing.T) {
+	db := setupHooksDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	item := &HookSaveItem{Value: 1}
+
+	if err := New[HookSaveItem]().SetDB(db).Create(ctx, item); err == nil {
+		t.Fatal("expected BeforeSave error to abort Create")
+	}
+	if len(item.Calls) != 0 {
+		t.Errorf("expected no further hooks after BeforeSave failed, got %v", item.Calls)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM hook_items").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected no row inserted, got %d", count)
+	}
+}
@@ -296,9 +296,8 @@ func TestJoin_RightJoin(t *testing.T) {
 }
 
 // J2: JOIN + Count().
-// Count() builds its own query that does not include JOIN clauses.
-// The WHERE condition on the primary table's column still applies, so the
-// count is correct for the filtered rows even though the JOIN is dropped.
+// Count() renders the JOIN clauses between FROM and WHERE, so conditions on
+// the joined table's columns apply to the count.
 func TestJoin_WithCount(t *testing.T) {
 	db := setupJoinDB(t)
 	defer db.Close()
@@ -318,6 +317,61 @@ func TestJoin_WithCount(t *testing.T) {
 	if count != 2 {
 		t.Errorf("expected count 2 for user_id=1, got %d", count)
 	}
+
+	// Filter on the joined table
+	count, err = New[JoinOrder]().
+		SetDB(db).
+		InnerJoin("join_users", "join_orders.user_id", "=", "join_users.id").
+		Where("join_users.name", "Bob").
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("JOIN+Count on joined column failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected count 1 for Bob, got %d", count)
+	}
+
+	// Bound JOIN args come before WHERE args
+	count, err = New[JoinOrder]().
+		SetDB(db).
+		JoinOn("join_payments",
+			JoinCondition{Left: "join_payments.order_id", Operator: "=", Right: "join_orders.id"},
+			JoinCondition{Left: "join_payments.status", Operator: "=", Value: "paid"},
+		).
+		Where("join_orders.amount", ">", 50).
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("JoinOn+Count failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 paid order, got %d", count)
+	}
+
+	// Grouped counts go through the subquery path
+	count, err = New[JoinOrder]().
+		SetDB(db).
+		Join("join_users", "join_orders.user_id", "=", "join_users.id").
+		GroupBy("join_users.name").
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("JOIN+GroupBy+Count failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 users with orders, got %d", count)
+	}
+}
+
+// InnerJoin renders the same clause as Join, between FROM and WHERE.
+func TestJoin_InnerJoin_Print(t *testing.T) {
+	query, _ := New[JoinOrder]().
+		InnerJoin("join_users", "join_orders.user_id", "=", "join_users.id").
+		Where("join_users.name", "Alice").
+		Print()
+
+	want := "FROM join_orders INNER JOIN join_users ON join_orders.user_id = join_users.id WHERE 1=1  AND join_users.name = $1"
+	if !strings.Contains(query, want) {
+		t.Errorf("expected %q in query, got: %s", want, query)
+	}
 }
 
 // J3: JOIN + Limit/Offset.
@@ -405,3 +459,177 @@ func TestJoin_Print_MultipleJoins(t *testing.T) {
 		t.Errorf("JOIN clauses are out of order in Print() output: %s", query)
 	}
 }
+
+func TestJoin_JoinOn_LikePrefix_Print(t *testing.T) {
+	query, args := New[JoinOrder]().
+		JoinOn("join_users", JoinCondition{
+			Left: "join_users.name", Operator: "like", Right: "join_orders.prefix", Value: "%",
+		}).
+		Where("join_orders.amount", ">", 50).
+		Print()
+
+	want := "INNER JOIN join_users ON join_users.name LIKE join_orders.prefix || $1"
+	if !strings.Contains(query, want) {
+		t.Errorf("expected %q in query, got: %s", want, query)
+	}
+	if !strings.Contains(query, "join_orders.amount > $2") {
+		t.Errorf("expected WHERE placeholder after JOIN placeholder, got: %s", query)
+	}
+	if len(args) != 2 || args[0] != "%" || args[1] != 50 {
+		t.Errorf("expected args [%% 50], got %v", args)
+	}
+}
+
+func TestJoin_JoinOn_LikePrefix_MySQLPrint(t *testing.T) {
+	SetDialect(DialectMySQL)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	query, args := New[JoinOrder]().
+		JoinOn("join_users", JoinCondition{
+			Left: "join_users.name", Operator: "LIKE", Right: "join_orders.prefix", Value: "%",
+		}).
+		Print()
+
+	// || is logical OR on MySQL, so the value is appended with CONCAT
+	want := "INNER JOIN join_users ON join_users.name LIKE CONCAT(join_orders.prefix, "
+	if !strings.Contains(query, want) {
+		t.Errorf("expected %q in query, got: %s", want, query)
+	}
+	if strings.Contains(query, "||") {
+		t.Errorf("expected no || operator on MySQL, got: %s", query)
+	}
+	if len(args) != 1 || args[0] != "%" {
+		t.Errorf("expected args [%%], got %v", args)
+	}
+}
+
+func TestJoin_JoinOn_LikePrefix(t *testing.T) {
+	db := setupJoinDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		ALTER TABLE join_orders ADD COLUMN prefix TEXT;
+		UPDATE join_orders SET prefix = 'Al' WHERE id = 1;
+		UPDATE join_orders SET prefix = 'Zed' WHERE id = 2;
+		UPDATE join_orders SET prefix = 'B' WHERE id = 3;
+	`)
+	if err != nil {
+		t.Fatalf("failed to add prefix column: %v", err)
+	}
+
+	results, err := New[JoinOrder]().
+		SetDB(db).
+		JoinOn("join_users",
+			JoinCondition{Left: "join_users.name", Operator: "LIKE", Right: "join_orders.prefix", Value: "%"},
+			JoinCondition{Left: "join_users.id", Operator: "<=", Value: 2},
+		).
+		Select("join_orders.id", "join_orders.user_id", "join_orders.amount").
+		OrderBy("join_orders.id", "ASC").
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("JoinOn failed: %v", err)
+	}
+	if len(results) != 2 || results[0].ID != 1 || results[1].ID != 3 {
+		t.Errorf("expected orders 1 and 3 to match by prefix, got %+v", results)
+	}
+}
+
+func TestJoin_JoinOn_Invalid_SetsBuildErr(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+		conds []JoinCondition
+	}{
+		{"no conditions", "join_users", nil},
+		{"bad operator", "join_users", []JoinCondition{{Left: "a.x", Operator: "IN", Right: "b.y"}}},
+		{"bad column", "join_users", []JoinCondition{{Left: "a.x; DROP", Operator: "=", Right: "b.y"}}},
+		{"missing right side", "join_users", []JoinCondition{{Left: "a.x", Operator: "="}}},
+		{"bad table", "users; DROP", []JoinCondition{{Left: "a.x", Operator: "=", Right: "b.y"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[JoinOrder]().LeftJoinOn(tt.table, tt.conds...)
+			if m.buildErr == nil {
+				t.Error("expected buildErr")
+			}
+		})
+	}
+}
+
+func TestJoin_JoinValues_Print(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	rows := [][]any{{1, 0.5}, {2, 0.9}}
+	cond := JoinCondition{Left: "s.order_id", Operator: "=", Right: "join_orders.id"}
+	tests := []struct {
+		dialect Dialect
+		want    string
+	}{
+		{DialectPostgres, "INNER JOIN (VALUES ($1::bigint, $2::double precision), ($3, $4)) AS s(order_id, score) ON s.order_id = join_orders.id"},
+		{DialectSQLite, "INNER JOIN (SELECT column1 AS order_id, column2 AS score FROM (VALUES ($1, $2), ($3, $4))) AS s ON s.order_id = join_orders.id"},
+		{DialectMySQL, "INNER JOIN (SELECT $1 AS order_id, $2 AS score UNION ALL SELECT $3, $4) AS s ON s.order_id = join_orders.id"},
+	}
+	for _, tt := range tests {
+		SetDialect(tt.dialect)
+		query, args := New[JoinOrder]().
+			JoinValues("s", []string{"order_id", "score"}, rows, cond).
+			Where("join_orders.amount", ">", 50).
+			Print()
+		if !strings.Contains(query, tt.want) {
+			t.Errorf("%s: expected %q in query, got: %s", tt.dialect, tt.want, query)
+		}
+		if !strings.Contains(query, "join_orders.amount > $5") {
+			t.Errorf("%s: expected WHERE placeholder after VALUES placeholders, got: %s", tt.dialect, query)
+		}
+		if len(args) != 5 || args[0] != 1 || args[3] != 0.9 || args[4] != 50 {
+			t.Errorf("%s: expected args [1 0.5 2 0.9 50], got %v", tt.dialect, args)
+		}
+	}
+}
+
+func TestJoin_JoinValues(t *testing.T) {
+	db := setupJoinDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	results, err := New[JoinOrder]().
+		SetDB(db).
+		JoinValues("s", []string{"order_id", "score"}, [][]any{{1, 0.2}, {2, 0.9}, {3, 0.5}},
+			JoinCondition{Left: "s.order_id", Operator: "=", Right: "join_orders.id"},
+		).
+		Select("join_orders.id", "join_orders.user_id", "join_orders.amount").
+		OrderBy("s.score", "DESC").
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("JoinValues failed: %v", err)
+	}
+	if len(results) != 3 || results[0].ID != 2 || results[1].ID != 3 || results[2].ID != 1 {
+		t.Errorf("expected orders ordered by joined score (2, 3, 1), got %+v", results)
+	}
+}
+
+func TestJoin_JoinValues_Invalid_SetsBuildErr(t *testing.T) {
+	cond := JoinCondition{Left: "s.id", Operator: "=", Right: "join_orders.id"}
+	tests := []struct {
+		name    string
+		alias   string
+		columns []string
+		rows    [][]any
+		conds   []JoinCondition
+	}{
+		{"bad alias", "s; DROP", []string{"id"}, [][]any{{1}}, []JoinCondition{cond}},
+		{"bad column", "s", []string{"s.id"}, [][]any{{1}}, []JoinCondition{cond}},
+		{"no rows", "s", []string{"id"}, nil, []JoinCondition{cond}},
+		{"ragged row", "s", []string{"id", "score"}, [][]any{{1, 2}, {3}}, []JoinCondition{cond}},
+		{"no conditions", "s", []string{"id"}, [][]any{{1}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[JoinOrder]().JoinValues(tt.alias, tt.columns, tt.rows, tt.conds...)
+			if m.buildErr == nil {
+				t.Error("expected buildErr")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package zorm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// jsonValue binds a field tagged `json` as its JSON encoding. Nil maps,
+// slices and pointers are stored as NULL.
+type jsonValue struct {
+	v reflect.Value
+}
+
+// Value implements driver.Valuer.
+func (j jsonValue) Value() (driver.Value, error) {
+	switch j.v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Pointer, reflect.Interface:
+		if j.v.IsNil() {
+			return nil, nil
+		}
+	}
+	b, err := json.Marshal(j.v.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("zorm: encoding JSON column: %w", err)
+	}
+	return string(b), nil
+}
+
+// jsonScanner decodes a JSON column into a field tagged `json`. NULL and
+// empty values leave the field at its zero value.
+type jsonScanner struct {
+	dst reflect.Value
+}
+
+// Scan implements sql.Scanner.
+func (s *jsonScanner) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("zorm: cannot scan %T into JSON field of type %s", src, s.dst.Type())
+	}
+
+	s.dst.Set(reflect.Zero(s.dst.Type()))
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, s.dst.Addr().Interface()); err != nil {
+		return fmt.Errorf("zorm: decoding JSON column into %s: %w", s.dst.Type(), err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type jsonSettings struct {
+	Theme string `json:"theme"`
+	Beta  bool   `json:"beta"`
+}
+
+type jsonModel struct {
+	ID       int            `zorm:"primaryKey"`
+	Meta     map[string]any `zorm:"json"`
+	Tags     []string       `zorm:"json"`
+	Settings *jsonSettings  `zorm:"json"`
+}
+
+func (jsonModel) TableName() string { return "json_docs" }
+
+func setupJSONDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`CREATE TABLE json_docs (id INTEGER PRIMARY KEY AUTOINCREMENT, meta TEXT, tags TEXT, settings TEXT)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	return db
+}
+
+func TestJSONColumn_RoundTrip(t *testing.T) {
+	db := setupJSONDB(t)
+	ctx := context.Background()
+
+	doc := &jsonModel{
+		Meta:     map[string]any{"source": "api", "retries": float64(3)},
+		Tags:     []string{"go", "orm"},
+		Settings: &jsonSettings{Theme: "dark", Beta: true},
+	}
+	if err := New[jsonModel]().SetDB(db).Create(ctx, doc); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(doc)
+
+	var rawTags string
+	if err := db.QueryRow(`SELECT tags FROM json_docs WHERE id = ?`, doc.ID).Scan(&rawTags); err != nil {
+		t.Fatalf("failed to read raw value: %v", err)
+	}
+	if rawTags != `["go","orm"]` {
+		t.Errorf("expected tags stored as JSON text, got %q", rawTags)
+	}
+
+	got, err := New[jsonModel]().SetDB(db).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	defer ClearOriginals(got)
+	if !reflect.DeepEqual(got.Meta, doc.Meta) {
+		t.Errorf("Meta = %v, want %v", got.Meta, doc.Meta)
+	}
+	if !reflect.DeepEqual(got.Tags, doc.Tags) {
+		t.Errorf("Tags = %v, want %v", got.Tags, doc.Tags)
+	}
+	if got.Settings == nil || *got.Settings != *doc.Settings {
+		t.Errorf("Settings = %+v, want %+v", got.Settings, doc.Settings)
+	}
+
+	got.Tags = append(got.Tags, "sql")
+	if err := New[jsonModel]().SetDB(db).Update(ctx, got); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	updated, err := New[jsonModel]().SetDB(db).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find after update failed: %v", err)
+	}
+	defer ClearOriginals(updated)
+	if want := []string{"go", "orm", "sql"}; !reflect.DeepEqual(updated.Tags, want) {
+		t.Errorf("Tags after update = %v, want %v", updated.Tags, want)
+	}
+}
+
+func TestJSONColumn_SaveAfterInPlaceMutation(t *testing.T) {
+	db := setupJSONDB(t)
+	ctx := context.Background()
+
+	doc := &jsonModel{
+		Meta:     map[string]any{"source": "api", "nested": map[string]any{"n": float64(1)}},
+		Tags:     []string{"go"},
+		Settings: &jsonSettings{Theme: "dark"},
+	}
+	if err := New[jsonModel]().SetDB(db).Create(ctx, doc); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(doc)
+
+	got, err := New[jsonModel]().SetDB(db).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	defer ClearOriginals(got)
+
+	got.Meta["source"] = "cli"
+	got.Meta["nested"].(map[string]any)["n"] = float64(2)
+	got.Tags[0] = "rust"
+	got.Settings.Beta = true
+	if !New[jsonModel]().HasDirtyFields(got) {
+		t.Fatal("expected in-place edits to mark the entity dirty")
+	}
+	if err := New[jsonModel]().SetDB(db).Save(ctx, got); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reloaded, err := New[jsonModel]().SetDB(db).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find after save failed: %v", err)
+	}
+	defer ClearOriginals(reloaded)
+	wantMeta := map[string]any{"source": "cli", "nested": map[string]any{"n": float64(2)}}
+	if !reflect.DeepEqual(reloaded.Meta, wantMeta) {
+		t.Errorf("Meta = %v, want %v", reloaded.Meta, wantMeta)
+	}
+	if want := []string{"rust"}; !reflect.DeepEqual(reloaded.Tags, want) {
+		t.Errorf("Tags = %v, want %v", reloaded.Tags, want)
+	}
+	if reloaded.Settings == nil || !reloaded.Settings.Beta {
+		t.Errorf("Settings = %+v, want Beta set", reloaded.Settings)
+	}
+}
+
+func TestJSONColumn_NullLeavesZeroValue(t *testing.T) {
+	db := setupJSONDB(t)
+	ctx := context.Background()
+
+	doc := &jsonModel{}
+	if err := New[jsonModel]().SetDB(db).Create(ctx, doc); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(doc)
+
+	var nulls int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM json_docs WHERE meta IS NULL AND tags IS NULL AND settings IS NULL`).Scan(&nulls); err != nil {
+		t.Fatal(err)
+	}
+	if nulls != 1 {
+		t.Errorf("expected nil JSON fields to be stored as NULL")
+	}
+
+	got, err := New[jsonModel]().SetDB(db).Find(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	defer ClearOriginals(got)
+	if got.Meta != nil || got.Tags != nil || got.Settings != nil {
+		t.Errorf("expected zero values for NULL JSON columns, got %+v", got)
+	}
+}
+
+func TestJSONColumn_InvalidJSON(t *testing.T) {
+	db := setupJSONDB(t)
+	if _, err := db.Exec(`INSERT INTO json_docs (id, tags) VALUES (1, 'not json')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New[jsonModel]().SetDB(db).Find(context.Background(), 1); err == nil {
+		t.Error("expected an error decoding invalid JSON")
+	}
+}
+
+func TestJSONTag_MakesStructPointerAColumn(t *testing.T) {
+	info := ParseModel[jsonModel]()
+	field, ok := info.Columns["settings"]
+	if !ok {
+		t.Fatal("expected settings to be a column")
+	}
+	if !field.IsJSON {
+		t.Error("expected settings to be flagged as JSON")
+	}
+	if _, ok := info.RelationFields["Settings"]; ok {
+		t.Error("expected a json-tagged struct pointer not to be a relation field")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"maps"
 	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -99,8 +100,10 @@ type Model[T any] struct {
 	wheres            []string
 	args              []any
 	orderBys          []string
+	orderArgs         []any // Bound args referenced by ORDER BY (OrderByField), emitted after WHERE/HAVING args
 	groupBys          []string
-	havings           []string
+	havings           []string // Conditions prefixed with their connector ("AND ..." / "OR ..."), like wheres
+	havingArgs        []any    // Bound args referenced by HAVING, emitted after WHERE args
 	distinct          bool
 	distinctOn        []string
 	limit             int
@@ -109,6 +112,15 @@ type Model[T any] struct {
 	relationCallbacks map[string]any                 // Map of relation name to callback function
 	morphRelations    map[string]map[string][]string // Map of relation -> type -> []relations
 	lockMode          string                         // Lock mode for SELECT ... FOR UPDATE/SHARE
+	selectExprs       []string                       // Computed select expressions (WithWindow, WithExists)
+	selectArgs        []any                          // Bound args referenced by selectExprs, emitted after CTE args
+	pivotOrders       map[string]string              // Map of BelongsToMany relation -> pivot ORDER BY (WithPivotOrderBy)
+	scanPositional    bool                           // Scan result columns by position into FieldOrder (ScanPositional)
+	stableOrder       bool                           // Append the primary key as an ORDER BY tiebreaker (StableOrder)
+	batchSize         int                            // Rows per INSERT for CreateMany/UpsertMany (BatchSize); 0 derives it from the parameter limit
+	emptyRelations    map[string][]int               // Relation -> indices of parents with no related rows (LoadSliceReport); never cloned
+	relationCounts    []string                       // Relations counted into Attributes after Get (WithCount)
+	relationAggs      []relationAggregate            // Relation sums/averages stored into Attributes after Get (WithSum, WithAvg)
 
 	// Resolver State (for primary/replica routing)
 	forcePrimary bool // Force use of primary database
@@ -124,6 +136,9 @@ type Model[T any] struct {
 	// JOIN State
 	joins []joinClause
 
+	// Memoized buildSelectQuery output; never cloned
+	selectCache atomic.Pointer[selectQueryCache]
+
 	// Statement Cache (optional)
 	stmtCache *StmtCache
 
@@ -137,8 +152,9 @@ type Model[T any] struct {
 	// that are surfaced at execution time (Get, First, Count, etc.)
 	buildErr error
 
-	// dialect controls dialect-specific SQL generation (notably IN-list rewriting).
-	// Populated from the configured *sql.DB at New[T]() / Acquire[T]() time.
+	// dialect controls dialect-specific SQL generation (placeholders,
+	// RETURNING, IN-list rewriting). Set by SetDialect; DialectAuto defers to
+	// the package-wide override and driver detection.
 	dialect Dialect
 }
 
@@ -156,14 +172,20 @@ type joinClause struct {
 	col1     string // left side of ON condition (empty for CROSS JOIN)
 	op       string // operator (e.g., "=")
 	col2     string // right side of ON condition (empty for CROSS JOIN)
+	on       string // prebuilt ON expression from JoinOn (overrides col1/op/col2)
+	args     []any  // bound values referenced by on
 }
 
 // New creates a new Model instance for type T.
+// Relations returned by T's DefaultRelations method, if any, are eager
+// loaded unless removed with Without or WithOnly.
 func New[T any]() *Model[T] {
+	info := ParseModel[T]()
 	return &Model[T]{
 		ctx:               context.Background(),
 		db:                GetGlobalDB(),
-		modelInfo:         ParseModel[T](),
+		modelInfo:         info,
+		relations:         slices.Clone(info.DefaultRelations),
 		relationCallbacks: make(map[string]any),
 		morphRelations:    make(map[string]map[string][]string),
 		forceReplica:      -1, // -1 means auto-select
@@ -188,6 +210,17 @@ func (m *Model[T]) effectiveDialect() Dialect {
 	return detectDialect(db)
 }
 
+// maxParams returns the bind-parameter limit for the model's dialect. All
+// chunking logic (bulk inserts, keyed updates, IN lists) sizes batches from it.
+func (m *Model[T]) maxParams() int {
+	return m.effectiveDialect().MaxPlaceholders()
+}
+
+// rebind rewrites query's `?` placeholders for the model's dialect.
+func (m *Model[T]) rebind(query string) string {
+	return m.effectiveDialect().Rebind(query)
+}
+
 // getModelPool returns the sync.Pool for the given model type T.
 func getModelPool[T any]() *sync.Pool {
 	var t T
@@ -236,6 +269,7 @@ func Acquire[T any]() *Model[T] {
 	m.modelInfo = modelInfo
 	m.forceReplica = -1
 	m.dialect = DialectAuto
+	m.relations = slices.Clone(modelInfo.DefaultRelations)
 	return m
 }
 
@@ -279,8 +313,10 @@ func (m *Model[T]) reset() {
 	}
 
 	m.orderBys = nil
+	m.orderArgs = nil
 	m.groupBys = nil
 	m.havings = nil
+	m.havingArgs = nil
 	m.distinct = false
 	m.distinctOn = nil
 	m.limit = 0
@@ -288,8 +324,18 @@ func (m *Model[T]) reset() {
 	m.relations = nil
 	m.joins = nil
 	m.lockMode = ""
+	m.selectExprs = nil
+	m.selectArgs = nil
+	m.pivotOrders = nil
+	m.emptyRelations = nil
+	m.relationCounts = nil
+	m.relationAggs = nil
+	m.batchSize = 0
+	m.scanPositional = false
+	m.stableOrder = false
 	m.forcePrimary = false
 	m.forceReplica = -1
+	m.selectCache.Store(nil)
 	m.rawQuery = ""
 	m.rawArgs = nil
 	m.ctes = nil
@@ -348,11 +394,14 @@ func (m *Model[T]) Clone() *Model[T] {
 		rawQuery:     m.rawQuery,
 		stmtCache:    m.stmtCache, // Preserve statement cache reference
 		lockMode:     m.lockMode,
+		batchSize:    m.batchSize,
 		forcePrimary: m.forcePrimary,
 		forceReplica: m.forceReplica,
 		buildErr:     m.buildErr,
 		dialect:      m.dialect,
 	}
+	newModel.scanPositional = m.scanPositional
+	newModel.stableOrder = m.stableOrder
 
 	// Copy slices
 	if len(m.columns) > 0 {
@@ -371,6 +420,10 @@ func (m *Model[T]) Clone() *Model[T] {
 		newModel.orderBys = make([]string, len(m.orderBys))
 		copy(newModel.orderBys, m.orderBys)
 	}
+	if len(m.orderArgs) > 0 {
+		newModel.orderArgs = make([]any, len(m.orderArgs))
+		copy(newModel.orderArgs, m.orderArgs)
+	}
 	if len(m.groupBys) > 0 {
 		newModel.groupBys = make([]string, len(m.groupBys))
 		copy(newModel.groupBys, m.groupBys)
@@ -379,6 +432,10 @@ func (m *Model[T]) Clone() *Model[T] {
 		newModel.havings = make([]string, len(m.havings))
 		copy(newModel.havings, m.havings)
 	}
+	if len(m.havingArgs) > 0 {
+		newModel.havingArgs = make([]any, len(m.havingArgs))
+		copy(newModel.havingArgs, m.havingArgs)
+	}
 	if len(m.distinctOn) > 0 {
 		newModel.distinctOn = make([]string, len(m.distinctOn))
 		copy(newModel.distinctOn, m.distinctOn)
@@ -387,10 +444,25 @@ func (m *Model[T]) Clone() *Model[T] {
 		newModel.relations = make([]string, len(m.relations))
 		copy(newModel.relations, m.relations)
 	}
+	if len(m.relationCounts) > 0 {
+		newModel.relationCounts = make([]string, len(m.relationCounts))
+		copy(newModel.relationCounts, m.relationCounts)
+	}
+	if len(m.relationAggs) > 0 {
+		newModel.relationAggs = slices.Clone(m.relationAggs)
+	}
 	if len(m.joins) > 0 {
 		newModel.joins = make([]joinClause, len(m.joins))
 		copy(newModel.joins, m.joins)
 	}
+	if len(m.selectExprs) > 0 {
+		newModel.selectExprs = make([]string, len(m.selectExprs))
+		copy(newModel.selectExprs, m.selectExprs)
+	}
+	if len(m.selectArgs) > 0 {
+		newModel.selectArgs = make([]any, len(m.selectArgs))
+		copy(newModel.selectArgs, m.selectArgs)
+	}
 	if len(m.rawArgs) > 0 {
 		newModel.rawArgs = make([]any, len(m.rawArgs))
 		copy(newModel.rawArgs, m.rawArgs)
@@ -406,6 +478,11 @@ func (m *Model[T]) Clone() *Model[T] {
 		maps.Copy(newModel.relationCallbacks, m.relationCallbacks)
 	}
 
+	if len(m.pivotOrders) > 0 {
+		newModel.pivotOrders = make(map[string]string, len(m.pivotOrders))
+		maps.Copy(newModel.pivotOrders, m.pivotOrders)
+	}
+
 	if len(m.morphRelations) > 0 {
 		newModel.morphRelations = make(map[string]map[string][]string, len(m.morphRelations))
 		for k, v := range m.morphRelations {
@@ -432,6 +509,57 @@ func (m *Model[T]) Clone() *Model[T] {
 	return newModel
 }
 
+// QueryState is a saved copy of a builder's filter state, produced by
+// Snapshot and applied with Restore.
+type QueryState struct {
+	columns   []string
+	wheres    []string
+	args      []any
+	orderBys  []string
+	orderArgs []any
+	limit     int
+	offset    int
+	buildErr  error
+}
+
+// Snapshot captures the current columns, WHERE conditions and args, ORDER BY,
+// LIMIT and OFFSET so they can be restored later with Restore. It is cheaper
+// than Clone when a builder is temporarily modified and then reverted.
+//
+// Example:
+//
+//	base := q.Snapshot()
+//	active, _ := q.Where("active", true).Get(ctx)
+//	q.Restore(base)
+//	admins, _ := q.Where("role", "admin").Get(ctx)
+func (m *Model[T]) Snapshot() QueryState {
+	return QueryState{
+		columns:   slices.Clone(m.columns),
+		wheres:    slices.Clone(m.wheres),
+		args:      slices.Clone(m.args),
+		orderBys:  slices.Clone(m.orderBys),
+		orderArgs: slices.Clone(m.orderArgs),
+		limit:     m.limit,
+		offset:    m.offset,
+		buildErr:  m.buildErr,
+	}
+}
+
+// Restore resets the builder state captured by Snapshot. State not covered
+// by Snapshot (joins, relations, grouping, etc.) is left untouched. The same
+// snapshot can be restored any number of times.
+func (m *Model[T]) Restore(s QueryState) *Model[T] {
+	m.columns = slices.Clone(s.columns)
+	m.wheres = slices.Clone(s.wheres)
+	m.args = slices.Clone(s.args)
+	m.orderBys = slices.Clone(s.orderBys)
+	m.orderArgs = slices.Clone(s.orderArgs)
+	m.limit = s.limit
+	m.offset = s.offset
+	m.buildErr = s.buildErr
+	return m
+}
+
 // WithContext sets the context for the query.
 func (m *Model[T]) WithContext(ctx context.Context) *Model[T] {
 	m.ctx = ctx
@@ -462,6 +590,18 @@ func (m *Model[T]) SetDB(db *sql.DB) *Model[T] {
 	return m
 }
 
+// SetDialect overrides the dialect for this model instance, taking
+// precedence over the package-wide SetDialect and driver detection. Pass
+// DialectAuto to restore them.
+//
+// Example:
+//
+//	zorm.New[User]().SetDB(mysqlDB).SetDialect(zorm.DialectMySQL).Create(ctx, user)
+func (m *Model[T]) SetDialect(d Dialect) *Model[T] {
+	m.dialect = d
+	return m
+}
+
 // WithStmtCache enables statement caching for this model instance.
 // The cache will be used to store and reuse prepared statements,
 // improving performance by avoiding re-preparation of frequently used queries.
@@ -115,6 +115,31 @@ func TestClone_DoesNotModifyOriginal(t *testing.T) {
 	}
 }
 
+// TestSnapshot_RestoresState verifies Restore reverts temporary modifications
+func TestSnapshot_RestoresState(t *testing.T) {
+	m := New[TestModel]().Select("id", "name").Where("active", true).OrderBy("id", "ASC").Limit(10)
+	wantQuery, wantArgs := m.Print()
+
+	snap := m.Snapshot()
+	m.Where("role", "admin").OrderBy("name", "DESC").Limit(1).Offset(5).Select("age")
+	if q, _ := m.Print(); q == wantQuery {
+		t.Fatal("expected modified query to differ from snapshot")
+	}
+
+	// Restore twice to make sure the snapshot itself isn't mutated by reuse
+	for i := 0; i < 2; i++ {
+		m.Restore(snap)
+		gotQuery, gotArgs := m.Print()
+		if gotQuery != wantQuery {
+			t.Errorf("restore %d: expected %q, got %q", i, wantQuery, gotQuery)
+		}
+		if len(gotArgs) != len(wantArgs) {
+			t.Errorf("restore %d: expected %d args, got %d", i, len(wantArgs), len(gotArgs))
+		}
+		m.Where("temp", 1)
+	}
+}
+
 // TestClone_CopiesContext verifies context is preserved
 func TestClone_CopiesContext(t *testing.T) {
 	ctx := context.WithValue(context.Background(), contextKey("key"), "value")
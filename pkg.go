@@ -22,6 +22,10 @@ const (
 	DialectPostgres
 	// DialectSQLite uses portable `IN (?, ?, ...)` syntax.
 	DialectSQLite
+	// DialectMySQL enables MySQL-specific operators such as the NULL-safe
+	// `<=>`, keeps `?` placeholders and inserts without RETURNING; everything
+	// else falls back to the portable forms used for SQLite.
+	DialectMySQL
 )
 
 // maxInArgs is PostgreSQL's hard per-statement bind-parameter limit (uint16).
@@ -29,6 +33,105 @@ const (
 // instead of generating an invalid query.
 const maxInArgs = 65535
 
+// defaultMaxPlaceholders holds each dialect's per-statement bind-parameter
+// limit. SQLite's is SQLITE_MAX_VARIABLE_NUMBER (32766 since 3.32; builds
+// compiled with the old default of 999 should call SetMaxPlaceholders).
+// MySQL's prepared-statement limit is also a uint16.
+var defaultMaxPlaceholders = map[Dialect]int{
+	DialectPostgres: maxInArgs,
+	DialectSQLite:   32766,
+	DialectMySQL:    65535,
+}
+
+var (
+	maxPlaceholdersMu        sync.RWMutex
+	maxPlaceholdersOverrides = map[Dialect]int{}
+)
+
+// SetMaxPlaceholders overrides the bind-parameter limit ZORM assumes for a
+// dialect when sizing IN lists and chunking bulk writes. Pass n <= 0 to
+// restore the built-in default.
+func SetMaxPlaceholders(d Dialect, n int) {
+	maxPlaceholdersMu.Lock()
+	defer maxPlaceholdersMu.Unlock()
+	if n <= 0 {
+		delete(maxPlaceholdersOverrides, d)
+		return
+	}
+	maxPlaceholdersOverrides[d] = n
+}
+
+// MaxPlaceholders returns the per-statement bind-parameter limit for the
+// dialect, honoring any SetMaxPlaceholders override. DialectAuto resolves
+// to the PostgreSQL limit, matching detectDialect's fallback.
+func (d Dialect) MaxPlaceholders() int {
+	if d == DialectAuto {
+		d = DialectPostgres
+	}
+	maxPlaceholdersMu.RLock()
+	n, ok := maxPlaceholdersOverrides[d]
+	maxPlaceholdersMu.RUnlock()
+	if ok {
+		return n
+	}
+	if n, ok := defaultMaxPlaceholders[d]; ok {
+		return n
+	}
+	return maxInArgs
+}
+
+// String returns the dialect's display name, used in error messages.
+func (d Dialect) String() string {
+	switch d {
+	case DialectPostgres:
+		return "PostgreSQL"
+	case DialectSQLite:
+		return "SQLite"
+	case DialectMySQL:
+		return "MySQL"
+	default:
+		return "auto"
+	}
+}
+
+// Rebind rewrites the `?` placeholders of query into the dialect's native
+// form: `$N` for PostgreSQL and SQLite (which accepts both), unchanged `?`
+// for MySQL. DialectAuto resolves to PostgreSQL, matching detectDialect's
+// fallback.
+func (d Dialect) Rebind(query string) string {
+	if d == DialectMySQL {
+		return query
+	}
+	return rebind(query)
+}
+
+// SupportsReturning reports whether the dialect accepts INSERT ... RETURNING.
+// Create and CreateMany read generated keys back with LastInsertId when it
+// does not.
+func (d Dialect) SupportsReturning() bool {
+	return d != DialectMySQL
+}
+
+// QuoteIdentifier quotes a table or column name for the dialect: backticks
+// for MySQL, double quotes otherwise. Each part of a dotted name is quoted
+// separately and embedded quote characters are doubled.
+//
+// Example:
+//
+//	DialectPostgres.QuoteIdentifier("public.users") // "public"."users"
+//	DialectMySQL.QuoteIdentifier("users")           // `users`
+func (d Dialect) QuoteIdentifier(name string) string {
+	quote := `"`
+	if d == DialectMySQL {
+		quote = "`"
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = quote + strings.ReplaceAll(part, quote, quote+quote) + quote
+	}
+	return strings.Join(parts, ".")
+}
+
 // globalDialect is the package-wide dialect override. Zero (DialectAuto)
 // means "detect from the configured *sql.DB".
 var globalDialect atomic.Uint32
@@ -52,6 +155,7 @@ var (
 		"*sqlite3.SQLiteDriver": DialectSQLite,
 		"*stdlib.Driver":        DialectPostgres, // pgx/v5/stdlib
 		"*pq.Driver":            DialectPostgres, // lib/pq
+		"*mysql.MySQLDriver":    DialectMySQL,    // go-sql-driver/mysql
 	}
 )
 
@@ -103,6 +207,27 @@ func detectDialect(db *sql.DB) Dialect {
 	return DialectPostgres
 }
 
+// encodeBool binds bool and non-nil *bool values as 1 or 0 for dialects
+// without a native boolean type (SQLite, MySQL), so values compare equal to
+// what those databases store. Other values and dialects pass through.
+func encodeBool(v any, d Dialect) any {
+	if d != DialectSQLite && d != DialectMySQL {
+		return v
+	}
+	switch b := v.(type) {
+	case bool:
+		if b {
+			return int64(1)
+		}
+		return int64(0)
+	case *bool:
+		if b != nil {
+			return encodeBool(*b, d)
+		}
+	}
+	return v
+}
+
 // buildInClause emits a WHERE-IN style fragment appropriate for the dialect.
 //
 // On PostgreSQL with a slice whose elements share a supported scalar type
@@ -112,8 +237,8 @@ func detectDialect(db *sql.DB) Dialect {
 //
 // On SQLite, or when the slice is mixed-type or of an unsupported element
 // type, it returns the classic `col IN (?, ?, ...)` form with spread args.
-// SQLite/fallback inputs larger than maxInArgs return a non-nil error
-// rather than silently generating SQL that the driver will reject.
+// SQLite/fallback inputs larger than the dialect's MaxPlaceholders return a
+// non-nil error rather than silently generating SQL that the driver will reject.
 //
 // An empty args slice returns `1=0` (matches nothing).
 func buildInClause(col string, args []any, dialect Dialect) (string, []any, error) {
@@ -143,8 +268,11 @@ func buildInClauseWithOperator(col string, args []any, dialect Dialect, negate b
 			return col + " = ANY(?)", []any{typed}, nil
 		}
 	}
-	if len(args) > maxInArgs {
-		return "", nil, fmt.Errorf("zorm: IN list of %d args exceeds PostgreSQL parameter limit %d; pass a typed []int64/[]uint64/[]string/[]float64/[]bool slice (homogeneous) to enable the ANY-array fast path", len(args), maxInArgs)
+	if limit := dialect.MaxPlaceholders(); len(args) > limit {
+		if dialect == DialectAuto {
+			dialect = DialectPostgres
+		}
+		return "", nil, fmt.Errorf("zorm: IN list of %d args exceeds %s parameter limit %d; pass a typed []int64/[]uint64/[]string/[]float64/[]bool slice (homogeneous) to enable the PostgreSQL ANY-array fast path", len(args), dialect, limit)
 	}
 
 	var sb strings.Builder
@@ -162,6 +290,30 @@ func buildInClauseWithOperator(col string, args []any, dialect Dialect, negate b
 	return sb.String(), out, nil
 }
 
+// dedupInArgs returns args with duplicate values removed, preserving the
+// order in which values were first seen. Values are keyed the same way the
+// pivot loaders key ids (anyToKeyString), so 1 and int64(1) collapse into one.
+// The input slice is returned unchanged when it contains no duplicates.
+func dedupInArgs(args []any) []any {
+	if len(args) < 2 {
+		return args
+	}
+	seen := make(map[string]struct{}, len(args))
+	out := make([]any, 0, len(args))
+	for _, v := range args {
+		key := anyToKeyString(v)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, v)
+	}
+	if len(out) == len(args) {
+		return args
+	}
+	return out
+}
+
 // toTypedArraySlice converts a homogeneous []any into a concrete typed slice
 // that pgx/v5 can encode as a PostgreSQL array parameter. Mixed-type or
 // unsupported slices return (nil, false) so the caller can fall back.
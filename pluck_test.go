@@ -2,6 +2,7 @@ package zorm
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 	"testing"
 )
@@ -28,3 +29,174 @@ func TestPluck_QueryBuilding(t *testing.T) {
 
 	t.Log("Pluck method exists with correct signature")
 }
+
+type PluckUser struct {
+	ID   int64
+	Name string
+	Age  int64
+}
+
+func (PluckUser) TableName() string { return "users" }
+
+func TestPluckTyped_StringColumn(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	names, err := PluckTyped[PluckUser, string](ctx, New[PluckUser]().SetDB(db).Where("active", 1).OrderBy("name", "ASC"), "name")
+	if err != nil {
+		t.Fatalf("PluckTyped failed: %v", err)
+	}
+
+	expected := []string{"Alice", "Bob", "Diana"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %d names, got %d: %v", len(expected), len(names), names)
+	}
+	for i, name := range names {
+		if name != expected[i] {
+			t.Errorf("expected names[%d] = %q, got %q", i, expected[i], name)
+		}
+	}
+}
+
+func TestPluckTyped_IntAndFloatColumns(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	m := New[PluckUser]().SetDB(db).OrderBy("age", "ASC")
+
+	ages, err := PluckTyped[PluckUser, int64](ctx, m, "age")
+	if err != nil {
+		t.Fatalf("PluckTyped int64 failed: %v", err)
+	}
+	expected := []int64{25, 28, 30, 35}
+	if len(ages) != len(expected) {
+		t.Fatalf("expected %d ages, got %d", len(expected), len(ages))
+	}
+	for i, age := range ages {
+		if age != expected[i] {
+			t.Errorf("expected ages[%d] = %d, got %d", i, expected[i], age)
+		}
+	}
+
+	// The model is not mutated, so it can be plucked again
+	floats, err := PluckTyped[PluckUser, float64](ctx, m, "age")
+	if err != nil {
+		t.Fatalf("PluckTyped float64 failed: %v", err)
+	}
+	if len(floats) != 4 || floats[0] != 25 || floats[3] != 35 {
+		t.Errorf("unexpected float ages: %v", floats)
+	}
+	if len(m.columns) != 0 {
+		t.Errorf("expected model columns to be untouched, got %v", m.columns)
+	}
+}
+
+func TestPluckTyped_NullableTypes(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	_, _ = db.Exec("UPDATE users SET email = NULL WHERE id = 1")
+
+	ctx := context.Background()
+	emails, err := PluckTyped[PluckUser, sql.NullString](ctx, New[PluckUser]().SetDB(db).OrderBy("id", "ASC"), "email")
+	if err != nil {
+		t.Fatalf("PluckTyped failed: %v", err)
+	}
+	if len(emails) != 4 {
+		t.Fatalf("expected 4 emails, got %d", len(emails))
+	}
+	if emails[0].Valid {
+		t.Errorf("expected emails[0] to be NULL, got %q", emails[0].String)
+	}
+	if !emails[1].Valid || emails[1].String != "bob@example.com" {
+		t.Errorf("expected emails[1] = 'bob@example.com', got %v", emails[1])
+	}
+}
+
+func TestPluckTyped_InvalidColumn(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	_, err := PluckTyped[PluckUser, string](context.Background(), New[PluckUser]().SetDB(db), "name; DROP TABLE users")
+	if err == nil {
+		t.Fatal("expected error for invalid column name")
+	}
+}
+
+func TestPluckMap(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	_, _ = db.Exec("UPDATE users SET email = NULL WHERE id = 2")
+
+	ctx := context.Background()
+	m := New[PluckUser]().SetDB(db)
+
+	names, err := m.PluckMap(ctx, "id", "name")
+	if err != nil {
+		t.Fatalf("PluckMap failed: %v", err)
+	}
+	expected := map[int64]string{1: "Alice", 2: "Bob", 3: "Charlie", 4: "Diana"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %d entries, got %d: %v", len(expected), len(names), names)
+	}
+	for id, name := range expected {
+		if names[id] != name {
+			t.Errorf("expected names[%d] = %q, got %v", id, name, names[id])
+		}
+	}
+
+	emails, err := m.PluckMap(ctx, "name", "email")
+	if err != nil {
+		t.Fatalf("PluckMap failed: %v", err)
+	}
+	if v, ok := emails["Bob"]; !ok || v != nil {
+		t.Errorf("expected NULL email for Bob, got %v (present=%v)", v, ok)
+	}
+	if emails["Alice"] != "alice@example.com" {
+		t.Errorf("expected Alice's email, got %v", emails["Alice"])
+	}
+
+	// Duplicate keys: the last row wins
+	roles, err := m.Clone().OrderBy("id", "ASC").PluckMap(ctx, "role", "name")
+	if err != nil {
+		t.Fatalf("PluckMap failed: %v", err)
+	}
+	if roles["admin"] != "Diana" || roles["user"] != "Charlie" {
+		t.Errorf("expected later rows to overwrite earlier ones, got %v", roles)
+	}
+}
+
+func TestPluckMap_InvalidColumn(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	rec, stop := RecordQueries(ctx)
+	defer stop()
+
+	result, err := New[PluckUser]().SetDB(db).PluckMap(ctx, "id", "name; DROP TABLE users")
+	if err == nil {
+		t.Fatal("expected error for invalid column name")
+	}
+	if result == nil || len(result) != 0 {
+		t.Errorf("expected an empty map, got %v", result)
+	}
+	if err := rec.AssertQueryCount(0); err != nil {
+		t.Error(err)
+	}
+
+	// A rejected condition surfaces instead of plucking the unfiltered table
+	result, err = New[PluckUser]().SetDB(db).WhereIn("id; DROP TABLE users", []any{1}).PluckMap(ctx, "id", "name")
+	if err == nil {
+		t.Fatal("expected the invalid where column error")
+	}
+	if result == nil || len(result) != 0 {
+		t.Errorf("expected an empty map, got %v", result)
+	}
+	if err := rec.AssertQueryCount(0); err != nil {
+		t.Error(err)
+	}
+}
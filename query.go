@@ -2,10 +2,13 @@ package zorm
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // validOperators is a whitelist of safe SQL operators for Where clauses.
@@ -84,6 +87,102 @@ func (m *Model[T]) DistinctBy(columns ...string) *Model[T] {
 	return m
 }
 
+// validWindowFuncs is a whitelist of window functions accepted by WithWindow.
+// lag and lead take a column argument, e.g. "lag(score)".
+var validWindowFuncs = map[string]bool{
+	"row_number": true,
+	"rank":       true,
+	"dense_rank": true,
+	"lag":        true,
+	"lead":       true,
+}
+
+// WithWindow adds a window function column to the SELECT list, aliased as
+// alias so it scans into the struct field mapped to that column.
+// fn must be one of row_number, rank, dense_rank, lag(column) or
+// lead(column). partitionBy is a comma-separated column list and may be
+// empty; orderBy is a column optionally followed by ASC or DESC.
+// All identifiers are validated to prevent SQL injection.
+//
+// Example:
+//
+//	Model[Player]().WithWindow("team_rank", "rank", "team", "score DESC")
+//	// SELECT *, RANK() OVER (PARTITION BY team ORDER BY score DESC) AS team_rank FROM players
+func (m *Model[T]) WithWindow(alias, fn, partitionBy, orderBy string) *Model[T] {
+	if err := ValidateColumnName(alias); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WithWindow: invalid alias %q: %w", alias, err)
+		return m
+	}
+
+	name, arg := strings.ToLower(strings.TrimSpace(fn)), ""
+	if open := strings.IndexByte(name, '('); open >= 0 && strings.HasSuffix(name, ")") {
+		name, arg = name[:open], strings.TrimSpace(name[open+1:len(name)-1])
+	}
+	if !validWindowFuncs[name] {
+		m.buildErr = fmt.Errorf("zorm: WithWindow: unsupported window function %q", fn)
+		return m
+	}
+	needsArg := name == "lag" || name == "lead"
+	if needsArg != (arg != "") {
+		m.buildErr = fmt.Errorf("zorm: WithWindow: invalid arguments for window function %q", fn)
+		return m
+	}
+	if arg != "" {
+		if err := ValidateColumnName(arg); err != nil {
+			m.buildErr = fmt.Errorf("zorm: WithWindow: invalid column %q: %w", arg, err)
+			return m
+		}
+	}
+
+	sb := GetStringBuilder()
+	defer PutStringBuilder(sb)
+	sb.WriteString(strings.ToUpper(name))
+	sb.WriteByte('(')
+	sb.WriteString(arg)
+	sb.WriteString(") OVER (")
+
+	if partitionBy = strings.TrimSpace(partitionBy); partitionBy != "" {
+		cols := strings.Split(partitionBy, ",")
+		for i, col := range cols {
+			col = strings.TrimSpace(col)
+			if err := ValidateColumnName(col); err != nil {
+				m.buildErr = fmt.Errorf("zorm: WithWindow: invalid partition column %q: %w", col, err)
+				return m
+			}
+			cols[i] = col
+		}
+		sb.WriteString("PARTITION BY ")
+		sb.WriteString(strings.Join(cols, ", "))
+	}
+
+	if orderBy = strings.TrimSpace(orderBy); orderBy != "" {
+		col, dir, _ := strings.Cut(orderBy, " ")
+		if err := ValidateColumnName(col); err != nil {
+			m.buildErr = fmt.Errorf("zorm: WithWindow: invalid order column %q: %w", col, err)
+			return m
+		}
+		dir = strings.ToUpper(strings.TrimSpace(dir))
+		if dir != "" && dir != "ASC" && dir != "DESC" {
+			m.buildErr = fmt.Errorf("zorm: WithWindow: invalid order direction %q", dir)
+			return m
+		}
+		if partitionBy != "" {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString("ORDER BY ")
+		sb.WriteString(col)
+		if dir != "" {
+			sb.WriteByte(' ')
+			sb.WriteString(dir)
+		}
+	}
+
+	sb.WriteString(") AS ")
+	sb.WriteString(alias)
+	m.selectExprs = append(m.selectExprs, sb.String())
+	return m
+}
+
 // Raw sets a raw SQL query and arguments.
 func (m *Model[T]) Raw(query string, args ...any) *Model[T] {
 	m.rawQuery = query
@@ -147,6 +246,20 @@ func (m *Model[T]) OrWhere(query any, args ...any) *Model[T] {
 	return m.addWhere("OR", query, args...)
 }
 
+// OrWhereGroup ORs a parenthesized group of conditions against the ones
+// before it. Conditions inside the group keep their own AND/OR connectors
+// and their args are bound in order.
+//
+// Example:
+//
+//	Model[User]().Where("active", true).OrWhereGroup(func(q *Model[User]) {
+//	    q.Where("role", "admin").Where("verified", true)
+//	})
+//	// WHERE active = $1 OR (role = $2 AND verified = $3)
+func (m *Model[T]) OrWhereGroup(fn func(*Model[T])) *Model[T] {
+	return m.addWhere("OR", fn)
+}
+
 func (m *Model[T]) addWhere(typ string, query any, args ...any) *Model[T] {
 	// 1. Handle Callback
 	if callback, ok := query.(func(*Model[T])); ok {
@@ -155,15 +268,22 @@ func (m *Model[T]) addWhere(typ string, query any, args ...any) *Model[T] {
 			db:        m.db,
 			tx:        m.tx,
 			modelInfo: m.modelInfo,
+			dialect:   m.dialect,
 		}
 		callback(nested)
+		if nested.buildErr != nil {
+			m.buildErr = nested.buildErr
+			return m
+		}
 		if len(nested.wheres) > 0 {
-			// Strip prefixes from nested wheres
-			var conditions []string
-			for _, w := range nested.wheres {
+			// Keep each nested condition's connector except the first's
+			conditions := make([]string, 0, len(nested.wheres))
+			for i, w := range nested.wheres {
 				w = strings.TrimSpace(w)
-				w = strings.TrimPrefix(w, "AND ")
-				w = strings.TrimPrefix(w, "OR ")
+				if i == 0 {
+					w = strings.TrimPrefix(w, "AND ")
+					w = strings.TrimPrefix(w, "OR ")
+				}
 				conditions = append(conditions, w)
 			}
 			grouped := "(" + strings.Join(conditions, " ") + ")"
@@ -181,7 +301,7 @@ func (m *Model[T]) addWhere(typ string, query any, args ...any) *Model[T] {
 				return m
 			}
 			m.wheres = append(m.wheres, typ+" "+k+" = ?")
-			m.args = append(m.args, v)
+			m.args = append(m.args, m.bindBools([]any{v})...)
 		}
 		return m
 	}
@@ -207,7 +327,7 @@ func (m *Model[T]) addWhere(typ string, query any, args ...any) *Model[T] {
 					return m
 				}
 				m.wheres = append(m.wheres, typ+" "+field.Column+" = ?")
-				m.args = append(m.args, val.FieldByIndex(field.Index).Interface())
+				m.args = append(m.args, m.bindBools([]any{val.FieldByIndex(field.Index).Interface()})...)
 			}
 		}
 		return m
@@ -259,10 +379,29 @@ func (m *Model[T]) addWhere(typ string, query any, args ...any) *Model[T] {
 	}
 
 	m.wheres = append(m.wheres, typ+" "+queryStr)
-	m.args = append(m.args, args...)
+	m.args = append(m.args, m.bindBools(args)...)
 	return m
 }
 
+// bindBools returns args with bool values encoded for the model's dialect
+// (see encodeBool). args is returned as-is when it holds no bools.
+func (m *Model[T]) bindBools(args []any) []any {
+	for i, a := range args {
+		switch a.(type) {
+		case bool, *bool:
+		default:
+			continue
+		}
+		d := m.effectiveDialect()
+		out := slices.Clone(args)
+		for j := i; j < len(out); j++ {
+			out[j] = encodeBool(out[j], d)
+		}
+		return out
+	}
+	return args
+}
+
 // validateWhereRawString checks a raw WHERE string fragment for the most
 // dangerous SQL injection patterns (comments, multi-statement) without
 // blocking legitimate expressions such as subqueries or IS TRUE / IS FALSE.
@@ -340,204 +479,891 @@ func (m *Model[T]) OrWhereNotNull(column string) *Model[T] {
 	return m
 }
 
-// Chunk processes the results in chunks to save memory.
-// Uses Clone() for each iteration to avoid mutating the original query state.
-func (m *Model[T]) Chunk(ctx context.Context, size int, callback func([]*T) error) error {
-	page := 1
-	for {
-		// Clone to avoid mutating the original model's limit/offset
-		q := m.Clone()
-		offset := (page - 1) * size
-		q.limit = size
-		q.offset = offset
-
-		results, err := q.Get(ctx)
-		if err != nil {
-			return err
-		}
-
-		if len(results) == 0 {
-			break
-		}
-
-		if err := callback(results); err != nil {
-			return err
-		}
-
-		if len(results) < size {
-			break
-		}
-
-		page++
+// WhereNullSafeEquals adds an AND condition that compares column and value
+// treating NULL as an ordinary value, so a nil value matches NULL rows.
+// Column names are validated to prevent SQL injection.
+//
+// PostgreSQL and SQLite use `col IS NOT DISTINCT FROM ?`; MySQL uses its
+// native `<=>` operator.
+//
+// Example:
+//
+//	Model[User]().WhereNullSafeEquals("manager_id", nil)
+//	// WHERE manager_id IS NOT DISTINCT FROM $1
+func (m *Model[T]) WhereNullSafeEquals(column string, value any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereNullSafeEquals: invalid column %q: %w", column, err)
+		return m
 	}
-	return nil
+	if m.effectiveDialect() == DialectMySQL {
+		m.wheres = append(m.wheres, "AND "+column+" <=> ?")
+	} else {
+		m.wheres = append(m.wheres, "AND "+column+" IS NOT DISTINCT FROM ?")
+	}
+	m.args = append(m.args, value)
+	return m
 }
 
-// WhereIn adds a WHERE IN clause.
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) WhereIn(column string, args []any) *Model[T] {
+// WhereDistinctFrom adds an AND condition matching rows whose column differs
+// from value, treating NULL as an ordinary value: NULL is distinct from any
+// non-NULL value and not distinct from NULL. Column names are validated to
+// prevent SQL injection.
+//
+// PostgreSQL and SQLite use `col IS DISTINCT FROM ?`; MySQL uses
+// `NOT (col <=> ?)`.
+//
+// Example:
+//
+//	Model[User]().WhereDistinctFrom("email", newEmail)
+//	// WHERE email IS DISTINCT FROM $1
+func (m *Model[T]) WhereDistinctFrom(column string, value any) *Model[T] {
 	if err := ValidateColumnName(column); err != nil {
-		m.buildErr = fmt.Errorf("zorm: WhereIn: invalid column %q: %w", column, err)
+		m.buildErr = fmt.Errorf("zorm: WhereDistinctFrom: invalid column %q: %w", column, err)
 		return m
 	}
-	frag, outArgs, err := buildInClause(column, args, m.effectiveDialect())
-	if err != nil {
-		m.buildErr = fmt.Errorf("zorm: WhereIn: %w", err)
-		return m
+	if m.effectiveDialect() == DialectMySQL {
+		m.wheres = append(m.wheres, "AND NOT ("+column+" <=> ?)")
+	} else {
+		m.wheres = append(m.wheres, "AND "+column+" IS DISTINCT FROM ?")
 	}
-	m.wheres = append(m.wheres, "AND "+frag)
-	m.args = append(m.args, outArgs...)
+	m.args = append(m.args, value)
 	return m
 }
 
-// OrWhereIn adds an OR condition that checks whether the given column is inside the given values.
-// Column names are validated to prevent SQL injection.
+// WhereCoalesce adds an AND condition comparing column against value with
+// NULLs replaced by fallback, i.e. `COALESCE(col, ?) op ?`. Both fallback and
+// value are bound. Column names are validated to prevent SQL injection and op
+// must be one of =, >, <, >=, <=, <>, !=.
 //
 // Example:
 //
-//	Model[User]().OrWhereIn("status", []string{"active", "inactive"})
-func (m *Model[T]) OrWhereIn(column string, args []any) *Model[T] {
+//	Model[Product]().WhereCoalesce("discount", 0, ">", 10)
+//	// WHERE COALESCE(discount, $1) > $2
+func (m *Model[T]) WhereCoalesce(column string, fallback any, op string, value any) *Model[T] {
 	if err := ValidateColumnName(column); err != nil {
-		m.buildErr = fmt.Errorf("zorm: OrWhereIn: invalid column %q: %w", column, err)
+		m.buildErr = fmt.Errorf("zorm: WhereCoalesce: invalid column %q: %w", column, err)
 		return m
 	}
-	frag, outArgs, err := buildInClause(column, args, m.effectiveDialect())
-	if err != nil {
-		m.buildErr = fmt.Errorf("zorm: OrWhereIn: %w", err)
+	op = strings.TrimSpace(op)
+	if !validComputedOperators[op] {
+		m.buildErr = fmt.Errorf("zorm: WhereCoalesce: invalid operator %q; use one of =, >, <, >=, <=, <>, !=", op)
 		return m
 	}
-	m.wheres = append(m.wheres, "OR "+frag)
-	m.args = append(m.args, outArgs...)
+	m.wheres = append(m.wheres, "AND COALESCE("+column+", ?) "+op+" ?")
+	m.args = append(m.args, fallback, value)
 	return m
 }
 
-// WhereNotIn adds a WHERE NOT IN clause.
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) WhereNotIn(column string, args []any) *Model[T] {
+// WhereBitAnd adds an AND condition on an integer flag column, comparing the
+// bitwise AND of column and mask against value, i.e. `(col & ?) op ?`. Both
+// mask and value are bound. Column names are validated to prevent SQL
+// injection and op must be one of =, >, <, >=, <=, <>, !=.
+//
+// Example:
+//
+//	const PermRead = 1 << 0
+//	Model[Role]().WhereBitAnd("permissions", PermRead, "=", PermRead)
+//	// WHERE (permissions & $1) = $2
+func (m *Model[T]) WhereBitAnd(column string, mask int, op string, value int) *Model[T] {
 	if err := ValidateColumnName(column); err != nil {
-		m.buildErr = fmt.Errorf("zorm: WhereNotIn: invalid column %q: %w", column, err)
+		m.buildErr = fmt.Errorf("zorm: WhereBitAnd: invalid column %q: %w", column, err)
 		return m
 	}
-	frag, outArgs, err := buildNotInClause(column, args, m.effectiveDialect())
-	if err != nil {
-		m.buildErr = fmt.Errorf("zorm: WhereNotIn: %w", err)
+	op = strings.TrimSpace(op)
+	if !validComputedOperators[op] {
+		m.buildErr = fmt.Errorf("zorm: WhereBitAnd: invalid operator %q; use one of =, >, <, >=, <=, <>, !=", op)
 		return m
 	}
-	m.wheres = append(m.wheres, "AND "+frag)
-	m.args = append(m.args, outArgs...)
+	m.wheres = append(m.wheres, "AND ("+column+" & ?) "+op+" ?")
+	m.args = append(m.args, mask, value)
 	return m
 }
 
-// OrWhereNotIn adds an OR condition that checks whether the given column is not inside the given values.
-// Column names are validated to prevent SQL injection.
+// WhereEqualsFold adds an AND condition that compares column and value
+// case-insensitively. Column names are validated to prevent SQL injection.
+//
+// SQLite uses `col = ? COLLATE NOCASE`, which can still use an index declared
+// with COLLATE NOCASE. Other dialects use `LOWER(col) = LOWER(?)`; on
+// PostgreSQL a plain index on col is not usable for this form, so create an
+// expression index on LOWER(col) (or store the column as citext, where the
+// LOWER calls are harmless).
 //
 // Example:
 //
-//	Model[User]().OrWhereNotIn("status", []string{"active", "inactive"})
-func (m *Model[T]) OrWhereNotIn(column string, args []any) *Model[T] {
+//	Model[User]().WhereEqualsFold("email", "Alice@Example.com")
+//	// WHERE LOWER(email) = LOWER($1)
+func (m *Model[T]) WhereEqualsFold(column string, value string) *Model[T] {
 	if err := ValidateColumnName(column); err != nil {
-		m.buildErr = fmt.Errorf("zorm: OrWhereNotIn: invalid column %q: %w", column, err)
+		m.buildErr = fmt.Errorf("zorm: WhereEqualsFold: invalid column %q: %w", column, err)
 		return m
 	}
-	frag, outArgs, err := buildNotInClause(column, args, m.effectiveDialect())
-	if err != nil {
-		m.buildErr = fmt.Errorf("zorm: OrWhereNotIn: %w", err)
-		return m
+	if m.effectiveDialect() == DialectSQLite {
+		m.wheres = append(m.wheres, "AND "+column+" = ? COLLATE NOCASE")
+	} else {
+		m.wheres = append(m.wheres, "AND LOWER("+column+") = LOWER(?)")
 	}
-	m.wheres = append(m.wheres, "OR "+frag)
-	m.args = append(m.args, outArgs...)
+	m.args = append(m.args, value)
 	return m
 }
 
-// OrderBy adds an ORDER BY clause.
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) OrderBy(column, direction string) *Model[T] {
+// WhereContains adds an AND condition matching rows whose column contains
+// substr. The LIKE wildcards % and _ and the backslash in substr are escaped,
+// so it is matched literally. Column names are validated to prevent SQL
+// injection.
+//
+// Example:
+//
+//	Model[File]().WhereContains("name", "50%_off")
+//	// WHERE name LIKE $1 ESCAPE '\'  -- $1 = "%50\%\_off%"
+func (m *Model[T]) WhereContains(column, substr string) *Model[T] {
+	return m.whereLikeLiteral("WhereContains", column, "%"+escapeLike(substr)+"%")
+}
+
+// WhereStartsWith adds an AND condition matching rows whose column starts
+// with prefix, matched literally as in WhereContains.
+//
+// Example:
+//
+//	Model[User]().WhereStartsWith("email", "admin_")
+//	// WHERE email LIKE $1 ESCAPE '\'  -- $1 = "admin\_%"
+func (m *Model[T]) WhereStartsWith(column, prefix string) *Model[T] {
+	return m.whereLikeLiteral("WhereStartsWith", column, escapeLike(prefix)+"%")
+}
+
+// WhereEndsWith adds an AND condition matching rows whose column ends with
+// suffix, matched literally as in WhereContains.
+//
+// Example:
+//
+//	Model[File]().WhereEndsWith("name", ".tar.gz")
+//	// WHERE name LIKE $1 ESCAPE '\'  -- $1 = "%.tar.gz"
+func (m *Model[T]) WhereEndsWith(column, suffix string) *Model[T] {
+	return m.whereLikeLiteral("WhereEndsWith", column, "%"+escapeLike(suffix))
+}
+
+// likeEscaper escapes the LIKE wildcards and the escape character itself.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes s so it matches literally inside a LIKE pattern that
+// uses backslash as its escape character.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// whereLikeLiteral adds `column LIKE pattern` with backslash as the escape
+// character. MySQL already escapes with backslash and would read '\' as an
+// unterminated string, so the ESCAPE clause is omitted there.
+func (m *Model[T]) whereLikeLiteral(method, column, pattern string) *Model[T] {
 	if err := ValidateColumnName(column); err != nil {
-		return m // Skip invalid column names
+		m.buildErr = fmt.Errorf("zorm: %s: invalid column %q: %w", method, column, err)
+		return m
 	}
-	// Validate direction is only ASC or DESC
-	dir := strings.ToUpper(strings.TrimSpace(direction))
-	if dir != "ASC" && dir != "DESC" {
-		dir = "DESC" // Default to DESC if invalid
+	if m.effectiveDialect() == DialectMySQL {
+		m.wheres = append(m.wheres, "AND "+column+" LIKE ?")
+	} else {
+		m.wheres = append(m.wheres, "AND "+column+" LIKE ? ESCAPE '\\'")
 	}
-
-	sb := GetStringBuilder()
-	sb.WriteString(column)
-	sb.WriteByte(' ')
-	sb.WriteString(dir)
-	m.orderBys = append(m.orderBys, sb.String())
-	PutStringBuilder(sb)
+	m.args = append(m.args, pattern)
 	return m
 }
 
-// GroupBy adds a GROUP BY clause.
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) GroupBy(columns ...string) *Model[T] {
-	for _, col := range columns {
+// WhereOverlaps adds an AND condition matching rows whose [startCol, endCol)
+// range overlaps [from, to), using the standard overlap test
+// `start_col < to AND end_col > from`. Ranges that merely touch at an
+// endpoint do not overlap. Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	Model[Booking]().WhereOverlaps("starts_at", "ends_at", from, to)
+//	// WHERE (starts_at < $1 AND ends_at > $2)
+func (m *Model[T]) WhereOverlaps(startCol, endCol string, from, to time.Time) *Model[T] {
+	for _, col := range []string{startCol, endCol} {
 		if err := ValidateColumnName(col); err != nil {
-			continue // Skip invalid column names
+			m.buildErr = fmt.Errorf("zorm: WhereOverlaps: invalid column %q: %w", col, err)
+			return m
 		}
-		m.groupBys = append(m.groupBys, col)
 	}
+	m.wheres = append(m.wheres, "AND ("+startCol+" < ? AND "+endCol+" > ?)")
+	m.args = append(m.args, to, from)
 	return m
 }
 
-// GroupByRollup adds a GROUP BY ROLLUP clause.
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) GroupByRollup(columns ...string) *Model[T] {
-	var validCols []string
-	for _, col := range columns {
-		if err := ValidateColumnName(col); err != nil {
-			continue // Skip invalid column names
-		}
-		validCols = append(validCols, col)
+// validComputedOperators is the whitelist of comparison operators accepted
+// by WhereComputed, WhereCoalesce and WhereBitAnd.
+var validComputedOperators = map[string]bool{
+	"=":  true,
+	">":  true,
+	"<":  true,
+	">=": true,
+	"<=": true,
+	"<>": true,
+	"!=": true,
+}
+
+// WhereComputed adds an AND condition comparing an arithmetic expression over
+// columns against a bound value. It is a narrower, safer alternative to
+// WhereRaw: expr may only contain column names, numeric literals, the
+// operators + - * / % and parentheses, and op must be a comparison operator.
+//
+// Example:
+//
+//	Model[Product]().WhereComputed("(stock - reserved)", "<", 5)
+//	// WHERE (stock - reserved) < $1
+func (m *Model[T]) WhereComputed(expr, op string, value any) *Model[T] {
+	if err := validateArithmeticExpr(expr); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereComputed: invalid expression %q: %w", expr, err)
+		return m
 	}
-	if len(validCols) > 0 {
-		sb := GetStringBuilder()
-		sb.WriteString("ROLLUP (")
-		sb.WriteString(strings.Join(validCols, ", "))
-		sb.WriteByte(')')
-		m.groupBys = append(m.groupBys, sb.String())
-		PutStringBuilder(sb)
+	if !validComputedOperators[op] {
+		m.buildErr = fmt.Errorf("zorm: WhereComputed: invalid operator %q; use one of =, >, <, >=, <=, <>, !=", op)
+		return m
 	}
+	m.wheres = append(m.wheres, "AND "+strings.TrimSpace(expr)+" "+op+" ?")
+	m.args = append(m.args, value)
 	return m
 }
 
-// GroupByCube adds a GROUP BY CUBE clause.
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) GroupByCube(columns ...string) *Model[T] {
-	var validCols []string
-	for _, col := range columns {
-		if err := ValidateColumnName(col); err != nil {
-			continue // Skip invalid column names
+// validateArithmeticExpr checks that expr is a well-formed arithmetic
+// expression made only of column names, numeric literals, + - * / % and
+// balanced parentheses. Operands and operators must alternate, so two
+// adjacent identifiers (e.g. "stock UNION") are rejected.
+func validateArithmeticExpr(expr string) error {
+	// "--" and "/*" would start SQL comments even though each character is
+	// individually allowed.
+	if strings.Contains(expr, "--") || strings.Contains(expr, "/*") {
+		return fmt.Errorf("%w: comment sequence in expression", ErrInvalidColumnName)
+	}
+	depth := 0
+	expectOperand := true
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			if !expectOperand {
+				return fmt.Errorf("%w: unexpected '(' at offset %d", ErrInvalidColumnName, i)
+			}
+			depth++
+			i++
+		case c == ')':
+			if expectOperand || depth == 0 {
+				return fmt.Errorf("%w: unexpected ')' at offset %d", ErrInvalidColumnName, i)
+			}
+			depth--
+			i++
+		case strings.IndexByte("+-*/%", c) >= 0:
+			// A leading '-' is a unary minus; any other operator needs a
+			// preceding operand.
+			if expectOperand && c != '-' {
+				return fmt.Errorf("%w: unexpected %q at offset %d", ErrInvalidColumnName, c, i)
+			}
+			expectOperand = true
+			i++
+		case c >= '0' && c <= '9':
+			if !expectOperand {
+				return fmt.Errorf("%w: unexpected number at offset %d", ErrInvalidColumnName, i)
+			}
+			j := i
+			for j < len(expr) && (expr[j] >= '0' && expr[j] <= '9' || expr[j] == '.') {
+				j++
+			}
+			expectOperand = false
+			i = j
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			if !expectOperand {
+				return fmt.Errorf("%w: unexpected identifier at offset %d", ErrInvalidColumnName, i)
+			}
+			j := i
+			for j < len(expr) && (expr[j] == '_' || expr[j] == '.' || expr[j] >= 'a' && expr[j] <= 'z' ||
+				expr[j] >= 'A' && expr[j] <= 'Z' || expr[j] >= '0' && expr[j] <= '9') {
+				j++
+			}
+			if err := ValidateColumnName(expr[i:j]); err != nil {
+				return err
+			}
+			expectOperand = false
+			i = j
+		default:
+			return fmt.Errorf("%w: invalid character %q at offset %d", ErrInvalidColumnName, c, i)
 		}
-		validCols = append(validCols, col)
 	}
-	if len(validCols) > 0 {
-		sb := GetStringBuilder()
-		sb.WriteString("CUBE (")
-		sb.WriteString(strings.Join(validCols, ", "))
-		sb.WriteByte(')')
-		m.groupBys = append(m.groupBys, sb.String())
-		PutStringBuilder(sb)
+	if expectOperand {
+		return fmt.Errorf("%w: incomplete expression", ErrInvalidColumnName)
 	}
-	return m
+	if depth != 0 {
+		return fmt.Errorf("%w: unbalanced parentheses", ErrInvalidColumnName)
+	}
+	return nil
 }
 
-// GroupByGroupingSets adds a GROUP BY GROUPING SETS clause.
-// Each slice in sets represents a grouping set.
-// Empty slice represents empty grouping set ().
-// Column names are validated to prevent SQL injection.
-func (m *Model[T]) GroupByGroupingSets(sets ...[]string) *Model[T] {
-	var setStrings []string
-	for _, set := range sets {
-		if len(set) == 0 {
-			setStrings = append(setStrings, "()")
-		} else {
-			var validCols []string
-			for _, col := range set {
-				if err := ValidateColumnName(col); err != nil {
-					continue // Skip invalid column names
+// Chunk processes the results in chunks to save memory.
+// Uses Clone() for each iteration to avoid mutating the original query state.
+// Relations added with With/WithCallback are eager-loaded for each chunk
+// before callback runs, so children are available without N+1 queries.
+func (m *Model[T]) Chunk(ctx context.Context, size int, callback func([]*T) error) error {
+	page := 1
+	for {
+		// Clone to avoid mutating the original model's limit/offset
+		q := m.Clone()
+		offset := (page - 1) * size
+		q.limit = size
+		q.offset = offset
+
+		results, err := q.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(results) == 0 {
+			break
+		}
+
+		if err := callback(results); err != nil {
+			return err
+		}
+
+		if len(results) < size {
+			break
+		}
+
+		page++
+	}
+	return nil
+}
+
+// ChunkById processes the results in chunks of size rows like Chunk, but
+// pages by primary key instead of OFFSET: each chunk is fetched with
+// `WHERE pk > <last pk of the previous chunk> ORDER BY pk ASC LIMIT size`.
+// Rows inserted or deleted while iterating therefore never make it skip or
+// repeat a row. Existing OrderBy clauses are replaced by the primary key
+// order, and selected columns must include the primary key.
+//
+// Example:
+//
+//	err := zorm.New[User]().Where("active", true).ChunkById(ctx, 500, func(users []*User) error {
+//	    return notify(users)
+//	})
+func (m *Model[T]) ChunkById(ctx context.Context, size int, callback func([]*T) error) error {
+	if size < 1 {
+		return fmt.Errorf("zorm: ChunkById: chunk size must be positive, got %d", size)
+	}
+	pkField, ok := m.modelInfo.Columns[m.modelInfo.PrimaryKey]
+	if !ok {
+		return fmt.Errorf("zorm: ChunkById: primary key field %q not found in model %s", m.modelInfo.PrimaryKey, m.modelInfo.TableName)
+	}
+	pk := m.modelInfo.PrimaryKey
+	if len(m.joins) > 0 {
+		pk = m.TableName() + "." + pk
+	}
+
+	var lastID any
+	for {
+		// Clone to avoid mutating the original model's wheres/limit/order
+		q := m.Clone()
+		if lastID != nil {
+			q.Where(pk, ">", lastID)
+		}
+		q.orderBys = []string{pk + " ASC"}
+		q.orderArgs = nil
+		q.limit = size
+		q.offset = 0
+
+		results, err := q.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(results) == 0 {
+			break
+		}
+
+		if err := callback(results); err != nil {
+			return err
+		}
+
+		if len(results) < size {
+			break
+		}
+
+		last := reflect.ValueOf(results[len(results)-1]).Elem().FieldByIndex(pkField.Index)
+		if last.IsZero() {
+			return fmt.Errorf("zorm: ChunkById: primary key %s was not selected", m.modelInfo.PrimaryKey)
+		}
+		lastID = last.Interface()
+	}
+	return nil
+}
+
+// EachGroup streams the query ordered by groupColumns and calls fn once per
+// group, detected by a change in the group key values between consecutive
+// rows. Only the rows of the current group are held in memory, so large
+// grouped reports don't have to be buffered. keys holds the group's values
+// for groupColumns, in order. Existing OrderBy clauses are applied after the
+// group columns. Group columns must map to fields of T. Relations requested
+// with With (and WithCount, WithSum, WithAvg) are loaded once per group,
+// batched over the group's rows, before fn is called.
+func (m *Model[T]) EachGroup(ctx context.Context, groupColumns []string, fn func(keys []any, rows []*T) error) error {
+	if len(groupColumns) == 0 {
+		return fmt.Errorf("zorm: EachGroup: at least one group column is required")
+	}
+	fields := make([]*FieldInfo, len(groupColumns))
+	for i, col := range groupColumns {
+		if err := ValidateColumnName(col); err != nil {
+			return fmt.Errorf("zorm: EachGroup: invalid column %q: %w", col, err)
+		}
+		f, ok := m.modelInfo.Columns[col]
+		if !ok {
+			return fmt.Errorf("zorm: EachGroup: column %q is not a field of %s", col, m.modelInfo.Type.Name())
+		}
+		fields[i] = f
+	}
+
+	q := m.Clone()
+	orderBys := make([]string, 0, len(groupColumns)+len(q.orderBys))
+	for _, col := range groupColumns {
+		orderBys = append(orderBys, col+" ASC")
+	}
+	q.orderBys = append(orderBys, q.orderBys...)
+
+	cursor, err := q.Cursor(ctx)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	var keys []any
+	var group []*T
+	flush := func() error {
+		if err := cursor.loadRelations(ctx, group); err != nil {
+			return err
+		}
+		return fn(keys, group)
+	}
+	for cursor.Next() {
+		entity, err := cursor.scanRow(ctx)
+		if err != nil {
+			return err
+		}
+		val := reflect.ValueOf(entity).Elem()
+		rowKeys := make([]any, len(fields))
+		for i, f := range fields {
+			rowKeys[i] = val.FieldByIndex(f.Index).Interface()
+		}
+
+		if len(group) > 0 && !sameGroupKeys(keys, rowKeys) {
+			if err := flush(); err != nil {
+				return err
+			}
+			group = nil
+		}
+		keys = rowKeys
+		group = append(group, entity)
+	}
+	if err := cursor.rows.Err(); err != nil {
+		return err
+	}
+
+	if len(group) > 0 {
+		return flush()
+	}
+	return nil
+}
+
+// sameGroupKeys reports whether two EachGroup key tuples are equal.
+func sameGroupKeys(a, b []any) bool {
+	for i := range a {
+		if anyToKeyString(a[i]) != anyToKeyString(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// WhereIn adds a WHERE IN clause.
+// Column names are validated to prevent SQL injection.
+// Duplicate values are dropped (first-seen order is preserved) so repeated
+// ids don't inflate the placeholder list.
+//
+// On PostgreSQL, values of a single type (int, int64, string, float64, bool,
+// ...) are bound as one array parameter, "col = ANY($1)", so the statement
+// text is the same for any list length and a statement cache
+// (WithStmtCache) reuses one prepared statement. Other dialects emit
+// "col IN (?, ?, ...)".
+func (m *Model[T]) WhereIn(column string, args []any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereIn: invalid column %q: %w", column, err)
+		return m
+	}
+	frag, outArgs, err := buildInClause(column, dedupInArgs(args), m.effectiveDialect())
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereIn: %w", err)
+		return m
+	}
+	m.wheres = append(m.wheres, "AND "+frag)
+	m.args = append(m.args, outArgs...)
+	return m
+}
+
+// WhereInSet adds a WHERE IN clause from the keys of a set, for callers that
+// keep allow/deny lists as maps. Keys are sorted so the generated SQL (and
+// any cached statement) is stable across calls; an empty set matches nothing.
+// Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	allowed := map[any]struct{}{"active": {}, "trial": {}}
+//	Model[User]().WhereInSet("status", allowed)
+func (m *Model[T]) WhereInSet(column string, set map[any]struct{}) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereInSet: invalid column %q: %w", column, err)
+		return m
+	}
+	args := make([]any, 0, len(set))
+	for k := range set {
+		args = append(args, k)
+	}
+	slices.SortFunc(args, func(a, b any) int {
+		return strings.Compare(anyToKeyString(a), anyToKeyString(b))
+	})
+	return m.WhereIn(column, args)
+}
+
+// OrWhereIn adds an OR condition that checks whether the given column is inside the given values.
+// Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	Model[User]().OrWhereIn("status", []string{"active", "inactive"})
+func (m *Model[T]) OrWhereIn(column string, args []any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: OrWhereIn: invalid column %q: %w", column, err)
+		return m
+	}
+	frag, outArgs, err := buildInClause(column, args, m.effectiveDialect())
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: OrWhereIn: %w", err)
+		return m
+	}
+	m.wheres = append(m.wheres, "OR "+frag)
+	m.args = append(m.args, outArgs...)
+	return m
+}
+
+// WhereNotIn adds a WHERE NOT IN clause.
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) WhereNotIn(column string, args []any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereNotIn: invalid column %q: %w", column, err)
+		return m
+	}
+	frag, outArgs, err := buildNotInClause(column, args, m.effectiveDialect())
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereNotIn: %w", err)
+		return m
+	}
+	m.wheres = append(m.wheres, "AND "+frag)
+	m.args = append(m.args, outArgs...)
+	return m
+}
+
+// OrWhereNotIn adds an OR condition that checks whether the given column is not inside the given values.
+// Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	Model[User]().OrWhereNotIn("status", []string{"active", "inactive"})
+func (m *Model[T]) OrWhereNotIn(column string, args []any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: OrWhereNotIn: invalid column %q: %w", column, err)
+		return m
+	}
+	frag, outArgs, err := buildNotInClause(column, args, m.effectiveDialect())
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: OrWhereNotIn: %w", err)
+		return m
+	}
+	m.wheres = append(m.wheres, "OR "+frag)
+	m.args = append(m.args, outArgs...)
+	return m
+}
+
+// WhereInSubquery adds an AND condition matching rows whose column is in the
+// result of sub, which must be a query builder such as *Model[U] selecting a
+// single column. The subquery is rendered when WhereInSubquery is called, so
+// later changes to sub do not affect m; its args are merged in placeholder
+// order. Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	authors := zorm.New[Post]().Select("user_id").Where("published", true)
+//	zorm.New[User]().WhereInSubquery("id", authors).Get(ctx)
+//	// WHERE 1=1  AND id IN (SELECT user_id FROM posts WHERE 1=1  AND published = $1)
+func (m *Model[T]) WhereInSubquery(column string, sub any) *Model[T] {
+	return m.whereSubquery("WhereInSubquery", "IN", column, sub)
+}
+
+// WhereNotInSubquery is the NOT IN variant of WhereInSubquery.
+func (m *Model[T]) WhereNotInSubquery(column string, sub any) *Model[T] {
+	return m.whereSubquery("WhereNotInSubquery", "NOT IN", column, sub)
+}
+
+// whereSubquery is the shared implementation for WhereInSubquery and
+// WhereNotInSubquery. Like CTE sub-builders, sub is accepted as any builder
+// exposing buildSelectQuery, since methods cannot take a type parameter.
+func (m *Model[T]) whereSubquery(method, op, column string, sub any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: invalid column %q: %w", method, column, err)
+		return m
+	}
+	builder, ok := sub.(interface {
+		buildSelectQuery() (string, []any)
+		buildError() error
+	})
+	if !ok || reflect.ValueOf(sub).IsNil() {
+		m.buildErr = fmt.Errorf("zorm: %s: subquery must be a query builder, got %T", method, sub)
+		return m
+	}
+	if err := builder.buildError(); err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: subquery: %w", method, err)
+		return m
+	}
+
+	query, args := builder.buildSelectQuery()
+
+	sb := GetStringBuilder()
+	sb.WriteString("AND ")
+	sb.WriteString(column)
+	sb.WriteByte(' ')
+	sb.WriteString(op)
+	sb.WriteString(" (")
+	sb.WriteString(strings.TrimSpace(query))
+	sb.WriteByte(')')
+	m.wheres = append(m.wheres, sb.String())
+	PutStringBuilder(sb)
+	m.args = append(m.args, args...)
+	return m
+}
+
+// WhereBetween adds an AND condition matching rows whose column lies in the
+// inclusive range [low, high]. As in SQL, a range with low greater than high
+// matches nothing. Column names are validated to prevent SQL injection.
+//
+// Example:
+//
+//	Model[Order]().WhereBetween("created_at", from, to)
+//	// WHERE created_at BETWEEN $1 AND $2
+func (m *Model[T]) WhereBetween(column string, low, high any) *Model[T] {
+	return m.addBetween("WhereBetween", "AND", column, false, low, high)
+}
+
+// WhereNotBetween adds an AND condition matching rows whose column lies
+// outside the inclusive range [low, high].
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) WhereNotBetween(column string, low, high any) *Model[T] {
+	return m.addBetween("WhereNotBetween", "AND", column, true, low, high)
+}
+
+// OrWhereBetween adds an OR condition matching rows whose column lies in the
+// inclusive range [low, high].
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) OrWhereBetween(column string, low, high any) *Model[T] {
+	return m.addBetween("OrWhereBetween", "OR", column, false, low, high)
+}
+
+func (m *Model[T]) addBetween(method, conj, column string, negate bool, low, high any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: invalid column %q: %w", method, column, err)
+		return m
+	}
+	op := " BETWEEN ? AND ?"
+	if negate {
+		op = " NOT BETWEEN ? AND ?"
+	}
+	m.wheres = append(m.wheres, conj+" "+column+op)
+	m.args = append(m.args, low, high)
+	return m
+}
+
+// WhereDate adds an AND condition matching rows whose timestamp column falls
+// on the given calendar date. value is a "2006-01-02" string or a time.Time,
+// whose date part is used. Column names are validated to prevent SQL
+// injection.
+//
+// PostgreSQL uses `CAST(col AS DATE) = ?`; MySQL and SQLite use
+// `DATE(col) = ?`.
+//
+// Example:
+//
+//	Model[Order]().WhereDate("created_at", "2024-01-01")
+//	// WHERE CAST(created_at AS DATE) = $1
+func (m *Model[T]) WhereDate(column string, value any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereDate: invalid column %q: %w", column, err)
+		return m
+	}
+	if t, ok := value.(time.Time); ok {
+		value = t.Format(time.DateOnly)
+	}
+	if m.effectiveDialect() == DialectPostgres {
+		m.wheres = append(m.wheres, "AND CAST("+column+" AS DATE) = ?")
+	} else {
+		m.wheres = append(m.wheres, "AND DATE("+column+") = ?")
+	}
+	m.args = append(m.args, value)
+	return m
+}
+
+// WhereYear adds an AND condition matching rows whose timestamp column falls
+// in the given year.
+//
+// Example:
+//
+//	Model[Order]().WhereYear("created_at", 2024)
+//	// WHERE EXTRACT(YEAR FROM created_at) = $1
+func (m *Model[T]) WhereYear(column string, year int) *Model[T] {
+	return m.whereDatePart("WhereYear", column, "YEAR", year)
+}
+
+// WhereMonth adds an AND condition matching rows whose timestamp column falls
+// in the given month (1-12) of any year.
+func (m *Model[T]) WhereMonth(column string, month int) *Model[T] {
+	return m.whereDatePart("WhereMonth", column, "MONTH", month)
+}
+
+// WhereDay adds an AND condition matching rows whose timestamp column falls
+// on the given day of the month (1-31).
+func (m *Model[T]) WhereDay(column string, day int) *Model[T] {
+	return m.whereDatePart("WhereDay", column, "DAY", day)
+}
+
+// sqliteDateFormats maps EXTRACT fields to their SQLite strftime format.
+var sqliteDateFormats = map[string]string{
+	"YEAR":  "%Y",
+	"MONTH": "%m",
+	"DAY":   "%d",
+}
+
+// whereDatePart adds `EXTRACT(part FROM column) = ?`. SQLite has no EXTRACT,
+// so it compares strftime's zero-padded text as an integer instead.
+func (m *Model[T]) whereDatePart(method, column, part string, value int) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: invalid column %q: %w", method, column, err)
+		return m
+	}
+	if m.effectiveDialect() == DialectSQLite {
+		m.wheres = append(m.wheres, "AND CAST(strftime('"+sqliteDateFormats[part]+"', "+column+") AS INTEGER) = ?")
+	} else {
+		m.wheres = append(m.wheres, "AND EXTRACT("+part+" FROM "+column+") = ?")
+	}
+	m.args = append(m.args, value)
+	return m
+}
+
+// OrderBy adds an ORDER BY clause.
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) OrderBy(column, direction string) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		return m // Skip invalid column names
+	}
+	// Validate direction is only ASC or DESC
+	dir := strings.ToUpper(strings.TrimSpace(direction))
+	if dir != "ASC" && dir != "DESC" {
+		dir = "DESC" // Default to DESC if invalid
+	}
+
+	sb := GetStringBuilder()
+	sb.WriteString(column)
+	sb.WriteByte(' ')
+	sb.WriteString(dir)
+	m.orderBys = append(m.orderBys, sb.String())
+	PutStringBuilder(sb)
+	return m
+}
+
+// OrderBySafe adds an ORDER BY clause only if column is one of allowed, and
+// silently ignores it otherwise. Use it for sort fields taken from user input
+// so callers can only sort on known (ideally indexed) columns. Chain a plain
+// OrderBy afterwards to keep a default ordering.
+//
+// Example:
+//
+//	New[User]().OrderBySafe(req.Sort, req.Dir, []string{"name", "created_at"})
+func (m *Model[T]) OrderBySafe(column, direction string, allowed []string) *Model[T] {
+	if !slices.Contains(allowed, column) {
+		return m
+	}
+	return m.OrderBy(column, direction)
+}
+
+// GroupBy adds a GROUP BY clause.
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) GroupBy(columns ...string) *Model[T] {
+	for _, col := range columns {
+		if err := ValidateColumnName(col); err != nil {
+			continue // Skip invalid column names
+		}
+		m.groupBys = append(m.groupBys, col)
+	}
+	return m
+}
+
+// GroupByRollup adds a GROUP BY ROLLUP clause.
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) GroupByRollup(columns ...string) *Model[T] {
+	var validCols []string
+	for _, col := range columns {
+		if err := ValidateColumnName(col); err != nil {
+			continue // Skip invalid column names
+		}
+		validCols = append(validCols, col)
+	}
+	if len(validCols) > 0 {
+		sb := GetStringBuilder()
+		sb.WriteString("ROLLUP (")
+		sb.WriteString(strings.Join(validCols, ", "))
+		sb.WriteByte(')')
+		m.groupBys = append(m.groupBys, sb.String())
+		PutStringBuilder(sb)
+	}
+	return m
+}
+
+// GroupByCube adds a GROUP BY CUBE clause.
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) GroupByCube(columns ...string) *Model[T] {
+	var validCols []string
+	for _, col := range columns {
+		if err := ValidateColumnName(col); err != nil {
+			continue // Skip invalid column names
+		}
+		validCols = append(validCols, col)
+	}
+	if len(validCols) > 0 {
+		sb := GetStringBuilder()
+		sb.WriteString("CUBE (")
+		sb.WriteString(strings.Join(validCols, ", "))
+		sb.WriteByte(')')
+		m.groupBys = append(m.groupBys, sb.String())
+		PutStringBuilder(sb)
+	}
+	return m
+}
+
+// GroupByGroupingSets adds a GROUP BY GROUPING SETS clause.
+// Each slice in sets represents a grouping set.
+// Empty slice represents empty grouping set ().
+// Column names are validated to prevent SQL injection.
+func (m *Model[T]) GroupByGroupingSets(sets ...[]string) *Model[T] {
+	var setStrings []string
+	for _, set := range sets {
+		if len(set) == 0 {
+			setStrings = append(setStrings, "()")
+		} else {
+			var validCols []string
+			for _, col := range set {
+				if err := ValidateColumnName(col); err != nil {
+					continue // Skip invalid column names
 				}
 				validCols = append(validCols, col)
 			}
@@ -577,6 +1403,20 @@ func (m *Model[T]) GroupByGroupingSets(sets ...[]string) *Model[T] {
 //
 // Example: Having("COUNT(*) > ?", 5)
 func (m *Model[T]) Having(query string, args ...any) *Model[T] {
+	return m.having("AND", query, args)
+}
+
+// OrHaving adds a HAVING condition joined with OR. See Having.
+//
+// Example:
+//
+//	GroupBy("user_id").Having("COUNT(*) > ?", 10).OrHaving("SUM(amount) > ?", 500)
+//	// HAVING COUNT(*) > $1 OR SUM(amount) > $2
+func (m *Model[T]) OrHaving(query string, args ...any) *Model[T] {
+	return m.having("OR", query, args)
+}
+
+func (m *Model[T]) having(connector, query string, args []any) *Model[T] {
 	// Validate query to prevent SQL injection
 	if err := ValidateRawQuery(query); err != nil {
 		return m // Skip invalid queries
@@ -599,8 +1439,29 @@ func (m *Model[T]) Having(query string, args ...any) *Model[T] {
 			query = trimmed + " = ?"
 		}
 	}
-	m.havings = append(m.havings, query)
-	m.args = append(m.args, args...)
+	m.havings = append(m.havings, connector+" "+query)
+	m.havingArgs = append(m.havingArgs, args...)
+	return m
+}
+
+// HavingRaw adds a HAVING condition exactly as written, joined with AND.
+// Unlike Having, expr is neither validated nor completed with an operator;
+// each ? is bound to the next arg. Never build expr from user input.
+//
+// Example:
+//
+//	HavingRaw("COUNT(*) FILTER (WHERE status = ?) > ?", "failed", 3)
+func (m *Model[T]) HavingRaw(expr string, args ...any) *Model[T] {
+	if strings.TrimSpace(expr) == "" {
+		m.buildErr = fmt.Errorf("zorm: HavingRaw: empty expression")
+		return m
+	}
+	if n := strings.Count(expr, "?"); n != len(args) {
+		m.buildErr = fmt.Errorf("zorm: HavingRaw: expression has %d placeholders but %d args were given", n, len(args))
+		return m
+	}
+	m.havings = append(m.havings, "AND "+expr)
+	m.havingArgs = append(m.havingArgs, args...)
 	return m
 }
 
@@ -634,12 +1495,141 @@ func (m *Model[T]) Offset(n int) *Model[T] {
 	return m
 }
 
+// ScanPositional scans result columns by position instead of by name: the
+// first column goes into the model's first mapped field, the second into the
+// second, and so on in struct declaration order (embedded fields inline).
+// It skips the per-query column-name mapping, which helps hot paths reading
+// large result sets with a fixed column list.
+//
+// The SELECT list must match the field order exactly; a mismatch silently
+// scans values into the wrong fields (or fails on a type mismatch). A query
+// returning more columns than the model has fields returns an error.
+//
+// Example:
+//
+//	New[User]().Select("id", "name", "email").ScanPositional().Get(ctx)
+func (m *Model[T]) ScanPositional() *Model[T] {
+	m.scanPositional = true
+	return m
+}
+
+// StableOrder appends the primary key as a final ORDER BY tiebreaker, so
+// rows with equal sort values (e.g. the same created_at) come back in the
+// same order on every page. It only applies when the query has an ORDER BY
+// that does not already end in the primary key, and is skipped for grouped
+// or DISTINCT queries, where the key may not be selectable.
+//
+// Example:
+//
+//	New[Post]().StableOrder().OrderBy("created_at", "DESC").Paginate(ctx, 2, 20)
+//	// ORDER BY created_at DESC, id ASC
+func (m *Model[T]) StableOrder() *Model[T] {
+	m.stableOrder = true
+	return m
+}
+
+// OrderByField orders rows by the position of column's value in values, so
+// results come back in the given order (e.g. ids in the order a cache
+// returned them). Column names are validated and values are bound. An empty
+// values list adds nothing.
+//
+// MySQL uses FIELD(), which sorts rows whose value is not listed first; other
+// dialects use a CASE expression that sorts them last.
+//
+// Example:
+//
+//	Model[User]().WhereIn("id", ids).OrderByField("id", ids)
+//	// ORDER BY CASE id WHEN $1 THEN 0 WHEN $2 THEN 1 ELSE 2 END
+func (m *Model[T]) OrderByField(column string, values []any) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: OrderByField: invalid column %q: %w", column, err)
+		return m
+	}
+	if len(values) == 0 {
+		return m
+	}
+
+	sb := GetStringBuilder()
+	if m.effectiveDialect() == DialectMySQL {
+		sb.WriteString("FIELD(")
+		sb.WriteString(column)
+		for range values {
+			sb.WriteString(", ?")
+		}
+		sb.WriteByte(')')
+	} else {
+		sb.WriteString("CASE ")
+		sb.WriteString(column)
+		for i := range values {
+			sb.WriteString(" WHEN ? THEN ")
+			sb.WriteString(strconv.Itoa(i))
+		}
+		sb.WriteString(" ELSE ")
+		sb.WriteString(strconv.Itoa(len(values)))
+		sb.WriteString(" END")
+	}
+	m.orderBys = append(m.orderBys, sb.String())
+	PutStringBuilder(sb)
+	m.orderArgs = append(m.orderArgs, values...)
+	return m
+}
+
+// OrderByRaw adds a raw SQL expression to ORDER BY, for orderings OrderBy
+// cannot express such as CASE expressions, function calls or weighted
+// ranks. Each ? in expr is bound to the next arg. Unlike OrderBy, the
+// expression is NOT validated: never build it from user input.
+//
+// Example:
+//
+//	Model[Task]().OrderByRaw("CASE WHEN priority = ? THEN 0 ELSE 1 END, due_at ASC", "urgent")
+//	Model[Quote]().OrderByRaw("RANDOM()").Limit(1)
+func (m *Model[T]) OrderByRaw(expr string, args ...any) *Model[T] {
+	if strings.TrimSpace(expr) == "" {
+		m.buildErr = fmt.Errorf("zorm: OrderByRaw: empty expression")
+		return m
+	}
+	if n := strings.Count(expr, "?"); n != len(args) {
+		m.buildErr = fmt.Errorf("zorm: OrderByRaw: expression has %d placeholders but %d args were given", n, len(args))
+		return m
+	}
+	m.orderBys = append(m.orderBys, expr)
+	m.orderArgs = append(m.orderArgs, args...)
+	return m
+}
+
 // Scope applies a function to the query builder.
 // Useful for reusable query logic (Scopes).
 func (m *Model[T]) Scope(fn func(*Model[T]) *Model[T]) *Model[T] {
 	return fn(m)
 }
 
+// When applies the given scopes in order only if condition is true, so
+// optional filters can be added without breaking the chain.
+// Returns m unchanged when condition is false.
+//
+// Example:
+//
+//	New[User]().
+//	    When(onlyActive, Active()).
+//	    When(search != "", func(q *Model[User]) *Model[User] {
+//	        return q.Where("name", "LIKE", "%"+search+"%")
+//	    })
+func (m *Model[T]) When(condition bool, fns ...func(*Model[T]) *Model[T]) *Model[T] {
+	if !condition {
+		return m
+	}
+	for _, fn := range fns {
+		m = fn(m)
+	}
+	return m
+}
+
+// Unless is the inverse of When: the scopes are applied only if condition
+// is false.
+func (m *Model[T]) Unless(condition bool, fns ...func(*Model[T]) *Model[T]) *Model[T] {
+	return m.When(!condition, fns...)
+}
+
 // PaginationResult holds pagination metadata and data.
 type PaginationResult[T any] struct {
 	Data        []*T  `json:"data"`
@@ -729,147 +1719,283 @@ func (m *Model[T]) SimplePaginate(ctx context.Context, page, perPage int) (*Pagi
 	}, nil
 }
 
-// WhereHas adds a WHERE EXISTS clause for a relation.
+// WhereHas keeps only rows that have at least one related row for relation,
+// using a correlated EXISTS subquery. Keys are derived like the eager
+// loaders for HasOne, HasMany, BelongsTo and BelongsToMany relations.
+// callback may be nil, a func(*Model[R]) adding WHERE constraints on the
+// related model R, or an already built *Model[R] whose conditions are used
+// as is; for BelongsToMany the related table is joined to the pivot so
+// constraints can reference its columns. An unknown relation or a callback
+// of another type makes the query fail with an error.
+//
+// Example:
+//
+//	New[User]().WhereHas("Posts", func(q *zorm.Model[Post]) {
+//	    q.Where("published", true)
+//	})
+//	// WHERE 1=1  AND EXISTS (SELECT 1 FROM posts WHERE posts.user_id = users.id AND published = $1)
 func (m *Model[T]) WhereHas(relation string, callback any) *Model[T] {
-	return m.whereHasInternal(relation, callback)
+	return m.whereHas("WhereHas", "EXISTS", relation, callback)
 }
 
-func (m *Model[T]) whereHasInternal(relation string, subQuery any) *Model[T] {
-	// 1. Get relation configuration
-	var t T
-	modelType := reflect.TypeOf(t)
-	ptrType := reflect.PointerTo(modelType)
+// WhereDoesntHave is the NOT EXISTS counterpart of WhereHas: it keeps only
+// rows with no related row matching callback's constraints.
+func (m *Model[T]) WhereDoesntHave(relation string, callback any) *Model[T] {
+	return m.whereHas("WhereDoesntHave", "NOT EXISTS", relation, callback)
+}
 
-	// Try finding method on ptrType or modelType
-	method, ok := ptrType.MethodByName(relation)
-	if !ok {
-		method, ok = modelType.MethodByName(relation)
-		if !ok {
-			// Try with "Relation" suffix
-			method, ok = ptrType.MethodByName(relation + "Relation")
-			if !ok {
-				method, ok = modelType.MethodByName(relation + "Relation")
-				if !ok {
-					return m // Relation method not found
-				}
-			}
-		}
+// whereHas is the shared implementation for WhereHas and WhereDoesntHave.
+func (m *Model[T]) whereHas(method, op, relation string, callback any) *Model[T] {
+	link, err := m.relationLinkFor(relation)
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: %w", method, err)
+		return m
+	}
+	rel, err := m.relationConfig(relation)
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: %w", method, err)
+		return m
 	}
 
-	// For whereHasInternal, we need to call the method.
-	// We use the zero value of the model.
-	res0 := reflect.ValueOf(t)
-	// If method is on pointer and res0 is not, we might need a pointer.
-	// But reflect.Value.MethodByName handles this if we use a pointer value.
-	ptrValue := reflect.New(modelType)
-	var methodVal reflect.Value
-	if method.Type.NumIn() > 0 && method.Type.In(0).Kind() == reflect.Pointer {
-		methodVal = ptrValue.MethodByName(method.Name)
-	} else {
-		methodVal = res0.MethodByName(method.Name)
+	var constraints []string
+	var args []any
+	if callback != nil {
+		relatedModel := rel.NewModel(m.ctx, m.db)
+		modelType := reflect.TypeOf(relatedModel)
+		fnVal := reflect.ValueOf(callback)
+		switch {
+		case fnVal.Type() == modelType:
+			// A query builder passed directly
+			relatedModel = callback
+		case fnVal.Kind() == reflect.Func && fnVal.Type().NumIn() == 1 && fnVal.Type().In(0) == modelType:
+			fnVal.Call([]reflect.Value{reflect.ValueOf(relatedModel)})
+		default:
+			m.buildErr = fmt.Errorf("zorm: %s: callback for relation %s must be a func(%T) or a %T, got %T", method, relation, relatedModel, relatedModel, callback)
+			return m
+		}
+		if builder, ok := relatedModel.(interface{ buildError() error }); ok {
+			if err := builder.buildError(); err != nil {
+				m.buildErr = fmt.Errorf("zorm: %s: relation %s: %w", method, relation, err)
+				return m
+			}
+		}
+		constraints, args = extractQueryConstraints(relatedModel)
 	}
 
-	if !methodVal.IsValid() {
-		// Fallback: try calling via method.Func with receiver
-		if method.Type.NumIn() > 0 {
-			if method.Type.In(0).Kind() == reflect.Pointer {
-				retVals := method.Func.Call([]reflect.Value{ptrValue})
-				return m.applyWhereHas(retVals, subQuery)
+	sb := GetStringBuilder()
+	sb.WriteString("AND ")
+	sb.WriteString(op)
+	sb.WriteString(" (SELECT 1 FROM ")
+	sb.WriteString(link.table)
+
+	// Constraints on a BelongsToMany relation refer to the related table,
+	// which is reached through the pivot.
+	if rel.RelationType() == RelationBelongsToMany && len(constraints) > 0 {
+		relatedInfo := ParseModelType(reflect.TypeOf(rel.NewRelated()).Elem())
+		relatedTable, relatedPK := relatedInfo.TableName, relatedInfo.PrimaryKey
+		valConfig := reflect.ValueOf(rel)
+		if override := valConfig.FieldByName("Table"); override.IsValid() && override.String() != "" {
+			relatedTable = override.String()
+		}
+		if pk := valConfig.FieldByName("RelatedPK"); pk.IsValid() && pk.String() != "" {
+			relatedPK = pk.String()
+		}
+		for _, ident := range []string{relatedTable, relatedPK} {
+			if err := ValidateColumnName(ident); err != nil {
+				PutStringBuilder(sb)
+				m.buildErr = fmt.Errorf("zorm: %s: relation %s: %w", method, relation, err)
+				return m
 			}
-			retVals := method.Func.Call([]reflect.Value{res0})
-			return m.applyWhereHas(retVals, subQuery)
 		}
-		return m
+		sb.WriteString(" INNER JOIN ")
+		sb.WriteString(relatedTable)
+		sb.WriteString(" ON ")
+		sb.WriteString(relatedTable)
+		sb.WriteByte('.')
+		sb.WriteString(relatedPK)
+		sb.WriteString(" = ")
+		sb.WriteString(link.table)
+		sb.WriteByte('.')
+		sb.WriteString(link.countColumn)
 	}
 
-	retVals := methodVal.Call(nil)
-	return m.applyWhereHas(retVals, subQuery)
+	sb.WriteString(" WHERE ")
+	sb.WriteString(link.table)
+	sb.WriteByte('.')
+	sb.WriteString(link.column)
+	sb.WriteString(" = ")
+	sb.WriteString(m.TableName())
+	sb.WriteByte('.')
+	sb.WriteString(link.parentColumn)
+	// Group the constraints when any is OR-joined so they cannot escape
+	// the correlation condition.
+	grouped := slices.ContainsFunc(constraints, func(w string) bool { return strings.HasPrefix(w, "OR ") })
+	if grouped {
+		sb.WriteString(" AND (1=1")
+	}
+	for _, w := range constraints {
+		sb.WriteByte(' ')
+		sb.WriteString(w)
+	}
+	if grouped {
+		sb.WriteByte(')')
+	}
+	sb.WriteByte(')')
+	m.wheres = append(m.wheres, sb.String())
+	PutStringBuilder(sb)
+	m.args = append(m.args, args...)
+	return m
 }
 
-func (m *Model[T]) applyWhereHas(retVals []reflect.Value, subQuery any) *Model[T] {
-	if len(retVals) == 0 {
+// WhereHasMorph keeps only rows whose MorphTo relation points at an existing
+// owner of one of the given types. types holds struct values registered in
+// the relation's TypeMap; one EXISTS subquery is built per type, keyed on the
+// type discriminator column, and the subqueries are OR-ed together. Each
+// callback is a func(*Model[R]) adding WHERE constraints on owner type R.
+//
+// Example:
+//
+//	New[Comment]().WhereHasMorph("Commentable", []any{Post{}, Video{}},
+//	    func(q *zorm.Model[Post]) { q.Where("published", true) })
+//	// WHERE 1=1  AND ((comments.commentable_type = $1 AND EXISTS (SELECT 1 FROM posts
+//	//   WHERE posts.id = comments.commentable_id AND published = $2))
+//	//   OR (comments.commentable_type = $3 AND EXISTS (SELECT 1 FROM videos
+//	//   WHERE videos.id = comments.commentable_id)))
+func (m *Model[T]) WhereHasMorph(relation string, types []any, callbacks ...any) *Model[T] {
+	rel, err := m.relationConfig(relation)
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: WhereHasMorph: %w", err)
 		return m
 	}
-
-	relConfig := retVals[0].Interface()
-	rel, ok := relConfig.(Relation)
+	morphRel, ok := rel.(MorphTo[any])
 	if !ok {
+		m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: expected MorphTo[any], got %T", relation, rel)
 		return m
 	}
-
-	// 2. Determine foreign key
-	valConfig := reflect.ValueOf(relConfig)
-	if valConfig.Kind() == reflect.Ptr {
-		valConfig = valConfig.Elem()
-	}
-	foreignKey := valConfig.FieldByName("ForeignKey").String()
-	if foreignKey == "" {
-		// Default: parent_table_id (e.g., user_id)
-		foreignKey = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
+	if len(types) == 0 {
+		m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: no morph types given", relation)
+		return m
 	}
 
-	// 3. Get related model info
-	relatedPtr := rel.NewRelated()
-	relatedType := reflect.TypeOf(relatedPtr).Elem()
-	relatedInfo := ParseModelType(relatedType)
-
-	// Extract Table Override
-	var relTable string
-	if overrider, ok := relConfig.(TableOverrider); ok {
-		relTable = overrider.GetOverrideTable()
+	// MorphTo names the struct fields holding the owner type and id, as the
+	// MorphTo loader reads them; column names are accepted too.
+	var morphColumns [2]string
+	for i, name := range []string{morphRel.Type, morphRel.ID} {
+		if f, ok := m.modelInfo.Fields[name]; ok {
+			morphColumns[i] = f.Column
+		} else if _, ok := m.modelInfo.Columns[name]; ok {
+			morphColumns[i] = name
+		} else {
+			m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: unknown morph field %q", relation, name)
+			return m
+		}
 	}
-	tableName := relatedInfo.TableName
-	if relTable != "" {
-		tableName = relTable
+	typeColumn, idColumn := morphColumns[0], morphColumns[1]
+
+	// Index the callbacks by the owner type they constrain.
+	callbackFor := make(map[reflect.Type]reflect.Value, len(callbacks))
+	for _, cb := range callbacks {
+		fnVal := reflect.ValueOf(cb)
+		var owner morphOwnerQuery
+		if fnVal.Kind() == reflect.Func && fnVal.Type().NumIn() == 1 && fnVal.Type().In(0).Kind() == reflect.Pointer {
+			owner, _ = reflect.New(fnVal.Type().In(0).Elem()).Interface().(morphOwnerQuery)
+		}
+		if owner == nil {
+			m.buildErr = fmt.Errorf("zorm: WhereHasMorph: callback for relation %s must be a func(*Model[R]), got %T", relation, cb)
+			return m
+		}
+		callbackFor[owner.modelType()] = fnVal
 	}
 
-	// 4. Build EXISTS subquery
-	var sb strings.Builder
-	sb.WriteString("EXISTS (SELECT 1 FROM ")
-	sb.WriteString(tableName)
-	sb.WriteString(" WHERE ")
-	sb.WriteString(tableName)
-	sb.WriteByte('.')
-	sb.WriteString(foreignKey)
-	sb.WriteString(" = ")
-	sb.WriteString(m.TableName())
-	sb.WriteByte('.')
-	sb.WriteString(m.modelInfo.PrimaryKey)
+	sb := GetStringBuilder()
+	defer PutStringBuilder(sb)
+	var args []any
+	sb.WriteString("AND (")
+	for i, typ := range types {
+		modelType := reflect.TypeOf(typ)
+		if modelType != nil && modelType.Kind() == reflect.Pointer {
+			modelType = modelType.Elem()
+		}
+		typeName := ""
+		for name, instance := range morphRel.TypeMap {
+			if t := reflect.TypeOf(instance); t == modelType || (t != nil && t.Kind() == reflect.Pointer && t.Elem() == modelType) {
+				typeName = name
+				break
+			}
+		}
+		if typeName == "" {
+			m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: type %T is not in its TypeMap", relation, typ)
+			return m
+		}
+		ownerInfo := ParseModelType(modelType)
+		if err := ValidateColumnName(ownerInfo.TableName); err != nil {
+			m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: %w", relation, err)
+			return m
+		}
 
-	// 5. Apply additional constraints from callback
-	if subQuery != nil {
 		var constraints []string
-		var args []any
-
-		// Check if it's a function
-		fnVal := reflect.ValueOf(subQuery)
-		if fnVal.Kind() == reflect.Func {
-			// Create a new model for the related type
-			relatedModel := rel.NewModel(m.ctx, m.db)
-			if relatedModel != nil {
-				fnVal.Call([]reflect.Value{reflect.ValueOf(relatedModel)})
-				// Extract constraints from the populated model
-				constraints, args = extractQueryConstraints(relatedModel)
+		var constraintArgs []any
+		if fnVal, ok := callbackFor[modelType]; ok {
+			delete(callbackFor, modelType)
+			owner := reflect.New(fnVal.Type().In(0).Elem()).Interface().(morphOwnerQuery).newModel(m.ctx, m.db)
+			fnVal.Call([]reflect.Value{reflect.ValueOf(owner)})
+			if err := owner.(interface{ buildError() error }).buildError(); err != nil {
+				m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: %w", relation, err)
+				return m
 			}
-		} else {
-			constraints, args = extractQueryConstraints(subQuery)
+			constraints, constraintArgs = extractQueryConstraints(owner)
 		}
 
-		if len(constraints) > 0 {
-			for _, w := range constraints {
-				sb.WriteString(" ")
-				sb.WriteString(w)
-			}
-			m.args = append(m.args, args...)
+		if i > 0 {
+			sb.WriteString(" OR ")
+		}
+		sb.WriteString("(")
+		sb.WriteString(m.TableName())
+		sb.WriteByte('.')
+		sb.WriteString(typeColumn)
+		sb.WriteString(" = ? AND EXISTS (SELECT 1 FROM ")
+		sb.WriteString(ownerInfo.TableName)
+		sb.WriteString(" WHERE ")
+		sb.WriteString(ownerInfo.TableName)
+		sb.WriteByte('.')
+		sb.WriteString(ownerInfo.PrimaryKey)
+		sb.WriteString(" = ")
+		sb.WriteString(m.TableName())
+		sb.WriteByte('.')
+		sb.WriteString(idColumn)
+		grouped := slices.ContainsFunc(constraints, func(w string) bool { return strings.HasPrefix(w, "OR ") })
+		if grouped {
+			sb.WriteString(" AND (1=1")
 		}
+		for _, w := range constraints {
+			sb.WriteByte(' ')
+			sb.WriteString(w)
+		}
+		if grouped {
+			sb.WriteByte(')')
+		}
+		sb.WriteString("))")
+		args = append(args, typeName)
+		args = append(args, constraintArgs...)
 	}
+	sb.WriteByte(')')
 
-	sb.WriteString(")")
-	m.wheres = append(m.wheres, "AND "+sb.String())
-
+	for paramType := range callbackFor {
+		m.buildErr = fmt.Errorf("zorm: WhereHasMorph: relation %s: callback for %s matches none of the given types", relation, paramType)
+		return m
+	}
+	m.wheres = append(m.wheres, sb.String())
+	m.args = append(m.args, args...)
 	return m
 }
 
+// morphOwnerQuery lets WhereHasMorph build a *Model[R] for an owner type R
+// that is only known through reflection. Its methods work on a zero model.
+type morphOwnerQuery interface {
+	modelType() reflect.Type
+	newModel(ctx context.Context, db *sql.DB) any
+}
+
 // extractQueryConstraints safely extracts WHERE clauses and args from a query builder.
 // Returns empty slices if extraction fails.
 func extractQueryConstraints(query any) ([]string, []any) {
@@ -909,6 +2035,26 @@ func extractQueryConstraints(query any) ([]string, []any) {
 	return wheres, args
 }
 
+// buildError returns the error recorded by builder methods, if any. It lets
+// WhereInSubquery check a sub-builder of another model type.
+func (m *Model[T]) buildError() error {
+	return m.buildErr
+}
+
+// modelType returns T; it is safe to call on a zero Model.
+func (m *Model[T]) modelType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// newModel returns a fresh query for T bound to ctx and db, like
+// Relation.NewModel does for statically typed relations.
+func (m *Model[T]) newModel(ctx context.Context, db *sql.DB) any {
+	q := New[T]()
+	q.ctx = ctx
+	q.db = db
+	return q
+}
+
 // GetWheres returns the where clauses.
 func (m *Model[T]) GetWheres() []string {
 	return m.wheres
@@ -924,6 +2070,11 @@ func (m *Model[T]) GetOrderBys() []string {
 	return m.orderBys
 }
 
+// GetOrderArgs returns the arguments bound by ORDER BY clauses.
+func (m *Model[T]) GetOrderArgs() []any {
+	return m.orderArgs
+}
+
 // GetLimit returns the limit value.
 func (m *Model[T]) GetLimit() int {
 	return m.limit
@@ -941,6 +2092,11 @@ func (m *Model[T]) Join(table, col1, op, col2 string) *Model[T] {
 	return m.addJoin("INNER JOIN", table, col1, op, col2)
 }
 
+// InnerJoin is an explicit alias for Join.
+func (m *Model[T]) InnerJoin(table, col1, op, col2 string) *Model[T] {
+	return m.addJoin("INNER JOIN", table, col1, op, col2)
+}
+
 // LeftJoin adds a LEFT JOIN clause.
 // Returns all rows from the left table and matching rows from the right table.
 // Column names are validated to prevent SQL injection.
@@ -967,7 +2123,282 @@ func (m *Model[T]) CrossJoin(table string) *Model[T] {
 	return m
 }
 
-// addJoin is the shared implementation for Join, LeftJoin, and RightJoin.
+// JoinCondition is one predicate of a structured JOIN ON expression built by
+// JoinOn and LeftJoinOn. Left is compared against Right, Value, or both:
+//
+//	{Left: "a.x", Operator: "=", Right: "b.y"}                 // a.x = b.y
+//	{Left: "a.x", Operator: "=", Value: 5}                     // a.x = ?
+//	{Left: "a.x", Operator: "LIKE", Right: "b.y", Value: "%"}  // a.x LIKE b.y || ?
+//
+// When both are set, Value is concatenated onto Right, which covers prefix
+// and suffix matching; MySQL renders this as CONCAT(b.y, ?). Values are
+// always bound as parameters.
+type JoinCondition struct {
+	Left     string
+	Operator string
+	Right    string
+	Value    any
+}
+
+// validJoinOperators is the whitelist of operators accepted in a JoinCondition.
+var validJoinOperators = map[string]bool{
+	"=":        true,
+	">":        true,
+	"<":        true,
+	">=":       true,
+	"<=":       true,
+	"<>":       true,
+	"!=":       true,
+	"LIKE":     true,
+	"NOT LIKE": true,
+	"ILIKE":    true,
+}
+
+// JoinOn adds an INNER JOIN whose ON expression is built from conditions
+// joined with AND. Table, column names and operators are validated.
+//
+// Example:
+//
+//	New[User]().JoinOn("logs", JoinCondition{
+//	    Left: "logs.path", Operator: "LIKE", Right: "users.prefix", Value: "%",
+//	})
+//	// INNER JOIN logs ON logs.path LIKE users.prefix || ?
+func (m *Model[T]) JoinOn(table string, conditions ...JoinCondition) *Model[T] {
+	return m.addJoinOn("INNER JOIN", table, conditions)
+}
+
+// LeftJoinOn is the LEFT JOIN variant of JoinOn.
+func (m *Model[T]) LeftJoinOn(table string, conditions ...JoinCondition) *Model[T] {
+	return m.addJoinOn("LEFT JOIN", table, conditions)
+}
+
+// addJoinOn is the shared implementation for JoinOn and LeftJoinOn.
+func (m *Model[T]) addJoinOn(joinType, table string, conditions []JoinCondition) *Model[T] {
+	if err := ValidateColumnName(table); err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: invalid table %q: %w", joinType, table, err)
+		return m
+	}
+	on, args, err := buildJoinConditions(joinType, conditions, m.effectiveDialect())
+	if err != nil {
+		m.buildErr = err
+		return m
+	}
+
+	m.joins = append(m.joins, joinClause{
+		joinType: joinType,
+		table:    table,
+		on:       on,
+		args:     args,
+	})
+	return m
+}
+
+// buildJoinConditions validates conditions and renders them as an ON
+// expression joined with AND, returning the values to bind. dialect selects
+// the string concatenation syntax used when a condition sets both Right and
+// Value, since || is logical OR on MySQL.
+func buildJoinConditions(joinType string, conditions []JoinCondition, dialect Dialect) (string, []any, error) {
+	if len(conditions) == 0 {
+		return "", nil, fmt.Errorf("zorm: %s: at least one JoinCondition is required", joinType)
+	}
+
+	sb := GetStringBuilder()
+	defer PutStringBuilder(sb)
+	var args []any
+	for i, c := range conditions {
+		if err := ValidateColumnName(c.Left); err != nil {
+			return "", nil, fmt.Errorf("zorm: %s: invalid column %q: %w", joinType, c.Left, err)
+		}
+		op := strings.ToUpper(strings.TrimSpace(c.Operator))
+		if !validJoinOperators[op] {
+			return "", nil, fmt.Errorf("zorm: %s: invalid join operator %q", joinType, c.Operator)
+		}
+		if c.Right == "" && c.Value == nil {
+			return "", nil, fmt.Errorf("zorm: %s: condition on %q needs a Right column or a Value", joinType, c.Left)
+		}
+		if c.Right != "" {
+			if err := ValidateColumnName(c.Right); err != nil {
+				return "", nil, fmt.Errorf("zorm: %s: invalid column %q: %w", joinType, c.Right, err)
+			}
+		}
+
+		if i > 0 {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString(c.Left)
+		sb.WriteByte(' ')
+		sb.WriteString(op)
+		sb.WriteByte(' ')
+		switch {
+		case c.Right != "" && c.Value != nil:
+			if dialect == DialectMySQL {
+				sb.WriteString("CONCAT(")
+				sb.WriteString(c.Right)
+				sb.WriteString(", ?)")
+			} else {
+				sb.WriteString(c.Right)
+				sb.WriteString(" || ?")
+			}
+			args = append(args, c.Value)
+		case c.Right != "":
+			sb.WriteString(c.Right)
+		default:
+			sb.WriteByte('?')
+			args = append(args, c.Value)
+		}
+	}
+	return sb.String(), args, nil
+}
+
+// JoinValues adds an INNER JOIN against an inline list of rows, exposed as
+// a derived table named alias with the given columns. It lets in-memory
+// data (e.g. scores computed in Go) be joined to database rows in one
+// query. All row values are bound; alias, columns and conditions are
+// validated as in JoinOn.
+//
+// PostgreSQL uses `(VALUES ...) AS alias(cols)` and casts the first row's
+// placeholders from their Go types so the columns are not inferred as text.
+// SQLite selects the VALUES columns under the given names, and MySQL
+// emulates the list with UNION ALL SELECTs.
+//
+// Example:
+//
+//	New[User]().JoinValues("s", []string{"user_id", "score"},
+//	    [][]any{{1, 0.9}, {2, 0.4}},
+//	    JoinCondition{Left: "s.user_id", Operator: "=", Right: "users.id"},
+//	).OrderBy("s.score", "DESC")
+//	// INNER JOIN (VALUES ($1::bigint, $2::double precision), ($3, $4)) AS s(user_id, score) ON s.user_id = users.id
+func (m *Model[T]) JoinValues(alias string, columns []string, rows [][]any, conditions ...JoinCondition) *Model[T] {
+	if err := ValidateColumnName(alias); err != nil {
+		m.buildErr = fmt.Errorf("zorm: JoinValues: invalid alias %q: %w", alias, err)
+		return m
+	}
+	if len(columns) == 0 || len(rows) == 0 {
+		m.buildErr = fmt.Errorf("zorm: JoinValues: at least one column and one row are required")
+		return m
+	}
+	for _, col := range columns {
+		if err := ValidateColumnName(col); err != nil || strings.ContainsAny(col, ". *(),") {
+			m.buildErr = fmt.Errorf("zorm: JoinValues: invalid column %q", col)
+			return m
+		}
+	}
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			m.buildErr = fmt.Errorf("zorm: JoinValues: row %d has %d values, expected %d", i, len(row), len(columns))
+			return m
+		}
+	}
+	on, onArgs, err := buildJoinConditions("JoinValues", conditions, m.effectiveDialect())
+	if err != nil {
+		m.buildErr = err
+		return m
+	}
+
+	dialect := m.effectiveDialect()
+	sb := GetStringBuilder()
+	defer PutStringBuilder(sb)
+	sb.WriteByte('(')
+	switch dialect {
+	case DialectMySQL:
+		for i := range rows {
+			if i > 0 {
+				sb.WriteString(" UNION ALL ")
+			}
+			sb.WriteString("SELECT ")
+			for j, col := range columns {
+				if j > 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteByte('?')
+				if i == 0 {
+					sb.WriteString(" AS ")
+					sb.WriteString(col)
+				}
+			}
+		}
+		sb.WriteString(") AS ")
+		sb.WriteString(alias)
+	case DialectSQLite:
+		sb.WriteString("SELECT ")
+		for j, col := range columns {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("column")
+			sb.WriteString(strconv.Itoa(j + 1))
+			sb.WriteString(" AS ")
+			sb.WriteString(col)
+		}
+		sb.WriteString(" FROM (VALUES ")
+		writeValuesRows(sb, rows, nil)
+		sb.WriteString(")) AS ")
+		sb.WriteString(alias)
+	default:
+		sb.WriteString("VALUES ")
+		writeValuesRows(sb, rows, pgValueCast)
+		sb.WriteString(") AS ")
+		sb.WriteString(alias)
+		sb.WriteByte('(')
+		sb.WriteString(strings.Join(columns, ", "))
+		sb.WriteByte(')')
+	}
+
+	args := make([]any, 0, len(rows)*len(columns)+len(onArgs))
+	for _, row := range rows {
+		args = append(args, row...)
+	}
+	args = append(args, onArgs...)
+
+	m.joins = append(m.joins, joinClause{
+		joinType: "INNER JOIN",
+		table:    sb.String(),
+		on:       on,
+		args:     args,
+	})
+	return m
+}
+
+// writeValuesRows writes "(?, ?), (?, ?)" for rows. When cast is non-nil,
+// each first-row placeholder is followed by cast(value).
+func writeValuesRows(sb *strings.Builder, rows [][]any, cast func(any) string) {
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for j, v := range row {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteByte('?')
+			if i == 0 && cast != nil {
+				sb.WriteString(cast(v))
+			}
+		}
+		sb.WriteByte(')')
+	}
+}
+
+// pgValueCast returns a PostgreSQL cast suffix for a Go value so a VALUES
+// column gets a concrete type instead of text. Unknown types get none.
+func pgValueCast(v any) string {
+	switch v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "::bigint"
+	case float32, float64:
+		return "::double precision"
+	case bool:
+		return "::boolean"
+	case string:
+		return "::text"
+	case time.Time:
+		return "::timestamptz"
+	}
+	return ""
+}
+
+// addJoin is the shared implementation for Join, InnerJoin, LeftJoin, and RightJoin.
 func (m *Model[T]) addJoin(joinType, table, col1, op, col2 string) *Model[T] {
 	if err := ValidateColumnName(table); err != nil {
 		m.buildErr = fmt.Errorf("zorm: %s: invalid table %q: %w", joinType, table, err)
@@ -997,6 +2428,7 @@ func (m *Model[T]) addJoin(joinType, table, col1, op, col2 string) *Model[T] {
 
 // With adds relations to eager load.
 // Multiple relation names can be specified, including nested relations.
+// Relations already in the eager-load set are not added again.
 //
 // Examples:
 //
@@ -1004,13 +2436,65 @@ func (m *Model[T]) addJoin(joinType, table, col1, op, col2 string) *Model[T] {
 //	With("Posts", "Comments")        // Multiple relations
 //	With("Posts.Comments")           // Nested relation
 func (m *Model[T]) With(relations ...string) *Model[T] {
-	m.relations = append(m.relations, relations...)
+	for _, rel := range relations {
+		if !slices.Contains(m.relations, rel) {
+			m.relations = append(m.relations, rel)
+		}
+	}
+	return m
+}
+
+// Without removes relations from the eager-load set, including ones added
+// by the model's DefaultRelations. Removing a relation also removes its
+// nested paths ("Posts" drops "Posts.Comments") and any callback, pivot
+// ordering or morph map registered for it.
+//
+// Example:
+//
+//	Model[User]().Without("Profile").Get(ctx)
+func (m *Model[T]) Without(relations ...string) *Model[T] {
+	m.relations = slices.DeleteFunc(m.relations, func(rel string) bool {
+		for _, name := range relations {
+			if rel == name || strings.HasPrefix(rel, name+".") {
+				m.dropRelationOptions(rel)
+				return true
+			}
+		}
+		return false
+	})
+	return m
+}
+
+// WithOnly replaces the eager-load set, including the model's
+// DefaultRelations, with relations. Callbacks, pivot orderings and morph
+// maps of relations no longer in the set are dropped; WithOnly() with no
+// arguments disables eager loading.
+//
+// Example:
+//
+//	Model[User]().WithOnly("Posts").Get(ctx)
+func (m *Model[T]) WithOnly(relations ...string) *Model[T] {
+	for _, rel := range m.relations {
+		if !slices.Contains(relations, rel) {
+			m.dropRelationOptions(rel)
+		}
+	}
+	m.relations = slices.Clone(relations)
 	return m
 }
 
+// dropRelationOptions forgets per-relation options set by WithCallback,
+// WithPivotOrderBy and WithMorph.
+func (m *Model[T]) dropRelationOptions(relation string) {
+	delete(m.relationCallbacks, relation)
+	delete(m.pivotOrders, relation)
+	delete(m.morphRelations, relation)
+}
+
 // WithCallback adds a relation with a callback to apply constraints.
 // The callback receives a query builder for the related model and can apply
-// filters, ordering, limits, etc.
+// filters, ordering, limits, etc. Relations declared only with a struct tag
+// have no typed builder, so WithCallback records an error for them.
 //
 // Example:
 //
@@ -1018,6 +2502,14 @@ func (m *Model[T]) With(relations ...string) *Model[T] {
 //	    q.Where("published", true).OrderBy("created_at", "DESC").Limit(10)
 //	})
 func (m *Model[T]) WithCallback(relation string, callback any) *Model[T] {
+	if _, ok := m.modelInfo.TagRelations[relation]; ok {
+		_, isMethod := m.modelInfo.RelationMethods[relation]
+		_, isSuffixed := m.modelInfo.RelationMethods[relation+"Relation"]
+		if !isMethod && !isSuffixed {
+			m.buildErr = fmt.Errorf("zorm: WithCallback: relation %q is declared with a struct tag; define a relation method to apply callback constraints", relation)
+			return m
+		}
+	}
 	if m.relationCallbacks == nil {
 		m.relationCallbacks = make(map[string]any)
 	}
@@ -1026,6 +2518,233 @@ func (m *Model[T]) WithCallback(relation string, callback any) *Model[T] {
 	return m
 }
 
+// WithPivotOrderBy eager loads a BelongsToMany relation and orders each
+// parent's related rows by a column of the pivot table.
+// The column and direction are validated; direction must be ASC or DESC.
+//
+// Example:
+//
+//	WithPivotOrderBy("Roles", "role_user.priority", "ASC")
+func (m *Model[T]) WithPivotOrderBy(relation, column, direction string) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WithPivotOrderBy: invalid column %q: %w", column, err)
+		return m
+	}
+	dir := strings.ToUpper(strings.TrimSpace(direction))
+	if dir != "ASC" && dir != "DESC" {
+		m.buildErr = fmt.Errorf("zorm: WithPivotOrderBy: invalid direction %q; use ASC or DESC", direction)
+		return m
+	}
+	if m.pivotOrders == nil {
+		m.pivotOrders = make(map[string]string)
+	}
+	m.pivotOrders[relation] = column + " " + dir
+	return m.With(relation)
+}
+
+// WithExists adds a boolean column to the SELECT list that reports whether
+// each row has at least one related row for relation, without loading it.
+// The column is aliased as alias so it scans into the matching bool field.
+// Supports HasOne, HasMany, BelongsTo and BelongsToMany relations.
+//
+// Example:
+//
+//	New[User]().WithExists("Posts", "has_posts")
+//	// SELECT *, EXISTS (SELECT 1 FROM posts WHERE posts.user_id = users.id) AS has_posts FROM users
+func (m *Model[T]) WithExists(relation, alias string) *Model[T] {
+	if err := ValidateColumnName(alias); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WithExists: invalid alias %q: %w", alias, err)
+		return m
+	}
+	expr, err := m.relationExistsSubquery(relation)
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: WithExists: %w", err)
+		return m
+	}
+	m.selectExprs = append(m.selectExprs, expr+" AS "+alias)
+	return m
+}
+
+// WithCount counts related rows without loading them. After the main query,
+// Get runs one SELECT key, COUNT(*) ... GROUP BY key per relation and stores
+// the count (an int64) in each result's Attributes map[string]any field
+// under "<relation>_count", e.g. "posts_count"; parents without related rows
+// get 0. Keys are resolved like the eager loaders for HasOne, HasMany,
+// BelongsTo and BelongsToMany (which counts pivot rows).
+//
+// A relation may carry simple equality filters on the counted table after a
+// colon, as "col=value" pairs separated by commas. Integer and true/false
+// values are bound as such, anything else as a string.
+//
+// Example:
+//
+//	users, err := New[User]().WithCount("Posts", "Comments:approved=true").Get(ctx)
+//	users[0].Attributes["posts_count"] // int64
+func (m *Model[T]) WithCount(relations ...string) *Model[T] {
+	m.relationCounts = append(m.relationCounts, relations...)
+	return m
+}
+
+// WithSum sums column over each result's related rows without loading them.
+// After the main query, Get runs one SELECT key, SUM(column) ... GROUP BY key
+// query and stores the sum (a float64) in each result's Attributes
+// map[string]any field under "<relation>_sum_<column>", e.g.
+// "orders_sum_total"; parents without related rows get 0. Only HasOne and
+// HasMany relations are supported.
+//
+// Example:
+//
+//	customers, err := New[Customer]().WithSum("Orders", "total").Get(ctx)
+//	customers[0].Attributes["orders_sum_total"] // float64
+func (m *Model[T]) WithSum(relation, column string) *Model[T] {
+	return m.withRelationAggregate("WithSum", "SUM", relation, column)
+}
+
+// WithAvg averages column over each result's related rows like WithSum,
+// storing the result under "<relation>_avg_<column>".
+//
+// Example:
+//
+//	customers, err := New[Customer]().WithAvg("Orders", "total").Get(ctx)
+//	customers[0].Attributes["orders_avg_total"] // float64
+func (m *Model[T]) WithAvg(relation, column string) *Model[T] {
+	return m.withRelationAggregate("WithAvg", "AVG", relation, column)
+}
+
+// withRelationAggregate validates and records a WithSum or WithAvg request.
+func (m *Model[T]) withRelationAggregate(method, fn, relation, column string) *Model[T] {
+	if err := ValidateColumnName(column); err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: invalid column %q: %w", method, column, err)
+		return m
+	}
+	rel, err := m.relationConfig(relation)
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: %s: %w", method, err)
+		return m
+	}
+	if t := rel.RelationType(); t != RelationHasOne && t != RelationHasMany {
+		m.buildErr = fmt.Errorf("zorm: %s: relation %s of type %s is not supported", method, relation, t)
+		return m
+	}
+	m.relationAggs = append(m.relationAggs, relationAggregate{fn: fn, relation: relation, column: column})
+	return m
+}
+
+// WithCountJoin adds the number of related rows for relation to the SELECT
+// list as alias, computed with a single LEFT JOIN and GROUP BY instead of a
+// query per relation. Rows without related rows get 0. Keys are resolved
+// from the relation config like WithExists; HasOne, HasMany, BelongsTo and
+// BelongsToMany (counted through the pivot table) are supported.
+//
+// The parent columns are qualified with the table name and added to GROUP
+// BY. Without a prior Select every model column except the aliases of
+// computed columns is selected, so call WithCountJoin after Select and
+// WithExists. Qualify columns in Where and OrderBy that the joined table
+// shares with the parent, such as id.
+//
+// Example:
+//
+//	New[User]().WithCountJoin("Posts", "posts_count")
+//	// SELECT users.id, users.name, COUNT(DISTINCT posts.id) AS posts_count FROM users
+//	// LEFT JOIN posts ON posts.user_id = users.id GROUP BY users.id, users.name
+func (m *Model[T]) WithCountJoin(relation, alias string) *Model[T] {
+	if err := ValidateColumnName(alias); err != nil {
+		m.buildErr = fmt.Errorf("zorm: WithCountJoin: invalid alias %q: %w", alias, err)
+		return m
+	}
+	link, err := m.relationLinkFor(relation)
+	if err != nil {
+		m.buildErr = fmt.Errorf("zorm: WithCountJoin: %w", err)
+		return m
+	}
+
+	parentTable := m.TableName()
+	if len(m.columns) == 0 {
+		computed := map[string]bool{alias: true}
+		for _, expr := range m.selectExprs {
+			if i := strings.LastIndex(expr, " AS "); i >= 0 {
+				computed[expr[i+len(" AS "):]] = true
+			}
+		}
+		for _, f := range m.modelInfo.FieldOrder {
+			if !computed[f.Column] {
+				m.columns = append(m.columns, parentTable+"."+f.Column)
+			}
+		}
+	} else {
+		// Qualify the selected columns, dropping alias in case an earlier
+		// WithCountJoin filled in every model column.
+		aliased := parentTable + "." + alias
+		columns := make([]string, 0, len(m.columns))
+		for _, col := range m.columns {
+			if !strings.ContainsAny(col, ". (") {
+				col = parentTable + "." + col
+			}
+			if col != aliased {
+				columns = append(columns, col)
+			}
+		}
+		m.columns = columns
+		m.groupBys = slices.DeleteFunc(slices.Clone(m.groupBys), func(col string) bool { return col == aliased })
+	}
+	for _, col := range m.columns {
+		if !slices.Contains(m.groupBys, col) {
+			m.groupBys = append(m.groupBys, col)
+		}
+	}
+
+	m.joins = append(m.joins, joinClause{
+		joinType: "LEFT JOIN",
+		table:    link.table,
+		col1:     link.table + "." + link.column,
+		op:       "=",
+		col2:     parentTable + "." + link.parentColumn,
+	})
+	m.selectExprs = append(m.selectExprs, "COUNT(DISTINCT "+link.table+"."+link.countColumn+") AS "+alias)
+	return m
+}
+
+// SelectCountFilter adds a conditional count to the SELECT list, counting only
+// the rows that match condition. Several can be combined with GroupBy to
+// compute multiple metrics in one pass. The condition is validated like
+// Having and its ? placeholders are bound to args.
+//
+// PostgreSQL uses `COUNT(*) FILTER (WHERE condition)`; other dialects use the
+// portable `SUM(CASE WHEN condition THEN 1 ELSE 0 END)`.
+//
+// Example:
+//
+//	New[User]().Select("team").
+//	    SelectCountFilter("active_count", "active = ?", true).
+//	    SelectCountFilter("inactive_count", "active = ?", false).
+//	    GroupBy("team")
+//	// SELECT team, COUNT(*) FILTER (WHERE active = $1) AS active_count, ...
+func (m *Model[T]) SelectCountFilter(alias, condition string, args ...any) *Model[T] {
+	if err := ValidateColumnName(alias); err != nil {
+		m.buildErr = fmt.Errorf("zorm: SelectCountFilter: invalid alias %q: %w", alias, err)
+		return m
+	}
+	if err := ValidateRawQuery(condition); err != nil {
+		m.buildErr = fmt.Errorf("zorm: SelectCountFilter: invalid condition: %w", err)
+		return m
+	}
+	if n := strings.Count(condition, "?"); n != len(args) {
+		m.buildErr = fmt.Errorf("zorm: SelectCountFilter: condition has %d placeholders but %d args were given", n, len(args))
+		return m
+	}
+
+	var expr string
+	switch m.effectiveDialect() {
+	case DialectPostgres:
+		expr = "COUNT(*) FILTER (WHERE " + condition + ") AS " + alias
+	default:
+		expr = "SUM(CASE WHEN " + condition + " THEN 1 ELSE 0 END) AS " + alias
+	}
+	m.selectExprs = append(m.selectExprs, expr)
+	m.selectArgs = append(m.selectArgs, args...)
+	return m
+}
+
 // WithMorph adds a polymorphic relation to eager load with type-specific constraints.
 // typeMap: map[string][]string{"events": {"Calendar"}, "posts": {"Author"}}
 func (m *Model[T]) WithMorph(relation string, typeMap map[string][]string) *Model[T] {
@@ -1175,7 +2894,9 @@ func (m *Model[T]) UseReplica(index int) *Model[T] {
 }
 
 // Print returns the SQL query and arguments that would be executed without running it.
-// This is useful for debugging and logging the generated SQL.
+// This is useful for debugging and logging the generated SQL. Placeholders
+// are always numbered ($1, $2, ...) so each maps to its argument, even for
+// MySQL, which executes the query with plain ? placeholders.
 // Example:
 //
 //	sql, args := m.Where("status", "active").Limit(10).Print()
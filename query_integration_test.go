@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -71,6 +74,90 @@ func TestQuery_Pagination(t *testing.T) {
 	}
 }
 
+func TestQuery_StableOrderPagination(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	// Every row shares the sort value
+	if _, err := db.Exec("UPDATE q_users SET email = 'same@example.com'"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var ids []int
+	for page := 1; page <= 3; page++ {
+		res, err := New[QUser]().SetDB(db).StableOrder().OrderBy("email", "DESC").Paginate(ctx, page, 2)
+		if err != nil {
+			t.Fatalf("Paginate(%d) failed: %v", page, err)
+		}
+		for _, u := range res.Data {
+			ids = append(ids, u.ID)
+		}
+	}
+
+	expected := []int{1, 2, 3, 4, 5}
+	if !slices.Equal(ids, expected) {
+		t.Errorf("expected ids %v across pages, got %v", expected, ids)
+	}
+}
+
+type QPost struct {
+	ID        int `zorm:"primaryKey"`
+	UserID    int
+	Title     string
+	Published bool
+}
+
+func (p QPost) TableName() string { return "q_posts" }
+
+func TestQuery_WhereInSubquery(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE q_posts (id INTEGER PRIMARY KEY, user_id INTEGER, title TEXT, published INTEGER);
+		INSERT INTO q_posts (user_id, title, published) VALUES
+		(1, 'Hello', 1), (1, 'Draft', 0), (2, 'Draft', 0), (3, 'News', 1), (5, 'News', 1);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup posts: %v", err)
+	}
+
+	ctx := context.Background()
+	published := New[QPost]().Select("user_id").Where("published", true)
+
+	users, err := New[QUser]().SetDB(db).
+		Where("id", "<", 5).
+		WhereInSubquery("id", published).
+		OrderBy("id", "ASC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereInSubquery failed: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != 1 || users[1].ID != 3 {
+		t.Errorf("expected users 1 and 3, got %+v", users)
+	}
+
+	users, err = New[QUser]().SetDB(db).WhereNotInSubquery("id", published).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereNotInSubquery failed: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != 2 || users[1].ID != 4 {
+		t.Errorf("expected users 2 and 4, got %+v", users)
+	}
+
+	// Two levels: users with a published post titled like one of user 3's posts
+	titles := New[QPost]().Select("title").Where("user_id", 3)
+	posts := New[QPost]().Select("user_id").Where("published", true).WhereInSubquery("title", titles)
+	users, err = New[QUser]().SetDB(db).WhereInSubquery("id", posts).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("nested WhereInSubquery failed: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != 3 || users[1].ID != 5 {
+		t.Errorf("expected users 3 and 5, got %+v", users)
+	}
+}
+
 func TestQuery_Chunk(t *testing.T) {
 	db := setupQueryDB(t)
 	defer db.Close()
@@ -94,6 +181,116 @@ func TestQuery_Chunk(t *testing.T) {
 	}
 }
 
+func TestQuery_ChunkById(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	var visited []int
+	var chunks int
+	err := New[QUser]().SetDB(db).OrderBy("name", "DESC").ChunkById(ctx, 2, func(users []*QUser) error {
+		chunks++
+		for _, u := range users {
+			visited = append(visited, u.ID)
+		}
+		if chunks == 1 {
+			// Deleting a visited row would make OFFSET paging skip user 3;
+			// appended rows must still be reached.
+			if _, err := db.Exec(`DELETE FROM q_users WHERE id = 1`); err != nil {
+				return err
+			}
+			if _, err := db.Exec(`INSERT INTO q_users (name, email) VALUES ('User 6', 'u6@example.com'), ('User 7', 'u7@example.com')`); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ChunkById failed: %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6, 7}; !slices.Equal(visited, want) {
+		t.Errorf("expected every user once in id order %v, got %v", want, visited)
+	}
+	if chunks != 4 {
+		t.Errorf("expected 4 chunks, got %d", chunks)
+	}
+
+	if err := New[QUser]().SetDB(db).ChunkById(ctx, 0, func([]*QUser) error { return nil }); err == nil {
+		t.Error("expected an error for a non-positive chunk size")
+	}
+
+	// The replaced ordering's bound args must not be sent with the chunk query.
+	visited = nil
+	err = New[QUser]().SetDB(db).OrderByField("id", []any{5, 4}).ChunkById(ctx, 3, func(users []*QUser) error {
+		for _, u := range users {
+			visited = append(visited, u.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ChunkById after OrderByField failed: %v", err)
+	}
+	if want := []int{2, 3, 4, 5, 6, 7}; !slices.Equal(visited, want) {
+		t.Errorf("expected ids in pk order %v, got %v", want, visited)
+	}
+}
+
+func TestQuery_EachGroup(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`UPDATE q_users SET email = CASE WHEN id % 2 = 1 THEN 'odd' ELSE 'even' END`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	var groups []string
+	var sizes []int
+	err = New[QUser]().OrderBy("id", "ASC").EachGroup(ctx, []string{"email"}, func(keys []any, users []*QUser) error {
+		groups = append(groups, keys[0].(string))
+		sizes = append(sizes, len(users))
+		for _, u := range users {
+			if u.Email != keys[0] {
+				t.Errorf("user %d with email %q delivered in group %v", u.ID, u.Email, keys)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("EachGroup failed: %v", err)
+	}
+
+	if len(groups) != 2 || groups[0] != "even" || groups[1] != "odd" {
+		t.Fatalf("expected groups [even odd], got %v", groups)
+	}
+	if sizes[0] != 2 || sizes[1] != 3 {
+		t.Errorf("expected group sizes [2 3], got %v", sizes)
+	}
+
+	// Callback errors stop iteration
+	calls := 0
+	stop := fmt.Errorf("stop")
+	err = New[QUser]().EachGroup(ctx, []string{"email"}, func(keys []any, users []*QUser) error {
+		calls++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("expected callback error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 callback before stopping, got %d", calls)
+	}
+
+	if err := New[QUser]().EachGroup(ctx, []string{"missing"}, func([]any, []*QUser) error { return nil }); err == nil {
+		t.Error("expected error for a group column that is not a model field")
+	}
+}
+
 type QUserWithPosts struct {
 	ID   int `zorm:"primaryKey"`
 	Name string
@@ -146,6 +343,120 @@ func TestQuery_WhereHas_Execution(t *testing.T) {
 		t.Errorf("expected 0 users with Post 'Other', got %d", len(users))
 	}
 }
+
+type QAuthor struct {
+	ID   int `zorm:"primaryKey"`
+	Name string
+}
+
+func (QAuthor) TableName() string { return "q_users" }
+func (QAuthor) PostsRelation() HasMany[QPost] {
+	return HasMany[QPost]{ForeignKey: "user_id"}
+}
+
+type QAuthoredPost struct {
+	ID     int `zorm:"primaryKey"`
+	UserID int
+	Title  string
+}
+
+func (QAuthoredPost) TableName() string { return "q_posts" }
+func (QAuthoredPost) AuthorRelation() BelongsTo[QUser] {
+	return BelongsTo[QUser]{ForeignKey: "user_id"}
+}
+
+func TestQuery_WhereHas_Constraints(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE q_posts (id INTEGER PRIMARY KEY, user_id INTEGER, title TEXT, published INTEGER);
+		INSERT INTO q_posts (user_id, title, published) VALUES
+		(1, 'Hello', 1), (2, 'Draft', 0), (3, 'News', 1), (3, 'Draft', 0);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup posts: %v", err)
+	}
+
+	ctx := context.Background()
+	published := func(q *Model[QPost]) {
+		q.Where("published", true)
+	}
+	ids := func(users []*QAuthor) []int {
+		var out []int
+		for _, u := range users {
+			out = append(out, u.ID)
+		}
+		return out
+	}
+
+	users, err := New[QAuthor]().SetDB(db).WhereHas("Posts", published).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereHas failed: %v", err)
+	}
+	if got := ids(users); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("expected users with a published post [1 3], got %v", got)
+	}
+
+	users, err = New[QAuthor]().SetDB(db).WhereDoesntHave("Posts", published).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereDoesntHave failed: %v", err)
+	}
+	if got := ids(users); !slices.Equal(got, []int{2, 4, 5}) {
+		t.Errorf("expected users without a published post [2 4 5], got %v", got)
+	}
+
+	users, err = New[QAuthor]().SetDB(db).WhereDoesntHave("Posts", nil).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereDoesntHave without constraints failed: %v", err)
+	}
+	if got := ids(users); !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("expected users without posts [4 5], got %v", got)
+	}
+
+	// OR constraints stay inside the correlated subquery
+	users, err = New[QAuthor]().SetDB(db).WhereHas("Posts", func(q *Model[QPost]) {
+		q.Where("title", "Hello").OrWhere("title", "News")
+	}).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereHas with OR failed: %v", err)
+	}
+	if got := ids(users); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("expected users [1 3], got %v", got)
+	}
+
+	// BelongsTo: the foreign key lives on the parent
+	posts, err := New[QAuthoredPost]().SetDB(db).WhereHas("Author", func(q *Model[QUser]) {
+		q.Where("name", "User 3")
+	}).Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereHas BelongsTo failed: %v", err)
+	}
+	if len(posts) != 2 || posts[0].UserID != 3 || posts[1].UserID != 3 {
+		t.Errorf("expected user 3's two posts, got %+v", posts)
+	}
+
+	// A query builder for the related model is accepted in place of a callback
+	users, err = New[QAuthor]().SetDB(db).WhereHas("Posts", New[QPost]().Where("published", true)).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereHas with a query builder failed: %v", err)
+	}
+	if got := ids(users); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("expected users with a published post [1 3], got %v", got)
+	}
+
+	// Callback of the wrong type
+	if m := New[QAuthor]().WhereHas("Posts", New[QUser]()); m.buildErr == nil {
+		t.Error("expected buildErr for a query builder of another model")
+	}
+	if m := New[QAuthor]().WhereHas("Posts", func(q *Model[QUser]) {}); m.buildErr == nil {
+		t.Error("expected buildErr for a mismatched callback")
+	}
+	if m := New[QAuthor]().WhereHas("Missing", nil); m.buildErr == nil {
+		t.Error("expected buildErr for an unknown relation")
+	}
+}
+
 func TestQuery_Security(t *testing.T) {
 	db := setupQueryDB(t)
 	defer db.Close()
@@ -188,3 +499,590 @@ func TestQuery_CreateMany_Batching(t *testing.T) {
 		t.Error("expected last user ID to be hydrated")
 	}
 }
+
+func TestQuery_WhereNullSafeEquals(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	if _, err := db.Exec(`UPDATE q_users SET email = NULL WHERE id IN (2, 4)`); err != nil {
+		t.Fatalf("failed to null emails: %v", err)
+	}
+
+	ctx := context.Background()
+
+	users, err := New[QUser]().SetDB(db).Select("id").WhereNullSafeEquals("email", nil).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereNullSafeEquals(nil) failed: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != 2 || users[1].ID != 4 {
+		t.Errorf("expected users 2 and 4 for NULL email, got %+v", users)
+	}
+
+	users, err = New[QUser]().SetDB(db).WhereNullSafeEquals("email", "u3@example.com").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereNullSafeEquals(value) failed: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != 3 {
+		t.Errorf("expected only user 3, got %+v", users)
+	}
+
+	users, err = New[QUser]().SetDB(db).WhereNullSafeEquals("email", "missing@example.com").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereNullSafeEquals(unmatched) failed: %v", err)
+	}
+	if len(users) != 0 {
+		t.Errorf("expected no users, got %+v", users)
+	}
+}
+
+func TestQuery_WhereDistinctFrom(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	if _, err := db.Exec(`UPDATE q_users SET email = NULL WHERE id IN (2, 4)`); err != nil {
+		t.Fatalf("failed to null emails: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// A plain <> would drop the NULL rows 2 and 4.
+	users, err := New[QUser]().SetDB(db).Select("id").WhereDistinctFrom("email", "u3@example.com").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereDistinctFrom(value) failed: %v", err)
+	}
+	if len(users) != 4 || users[0].ID != 1 || users[1].ID != 2 || users[2].ID != 4 || users[3].ID != 5 {
+		t.Errorf("expected users 1, 2, 4, 5, got %+v", users)
+	}
+
+	users, err = New[QUser]().SetDB(db).Select("id").WhereDistinctFrom("email", nil).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereDistinctFrom(nil) failed: %v", err)
+	}
+	if len(users) != 3 || users[0].ID != 1 || users[1].ID != 3 || users[2].ID != 5 {
+		t.Errorf("expected users 1, 3, 5 with non-NULL email, got %+v", users)
+	}
+}
+
+func TestQuery_WhereCoalesce(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`UPDATE q_users SET name = NULL WHERE id IN (2, 4)`); err != nil {
+		t.Fatalf("failed to null names: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// NULL names compare as the fallback; a plain = would match nothing.
+	users, err := New[QUser]().SetDB(db).Select("id").WhereCoalesce("name", "anonymous", "=", "anonymous").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereCoalesce failed: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != 2 || users[1].ID != 4 {
+		t.Errorf("expected users 2, 4, got %+v", users)
+	}
+
+	users, err = New[QUser]().SetDB(db).Select("id").WhereCoalesce("name", "", "<>", "").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereCoalesce(<>) failed: %v", err)
+	}
+	if len(users) != 3 || users[0].ID != 1 || users[1].ID != 3 || users[2].ID != 5 {
+		t.Errorf("expected users 1, 3, 5, got %+v", users)
+	}
+}
+
+func TestQuery_WhereBitAnd(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// Treat id as a flag column: bit 2 is set for ids 2 and 3.
+	users, err := New[QUser]().SetDB(db).Select("id").WhereBitAnd("id", 2, "=", 2).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereBitAnd failed: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != 2 || users[1].ID != 3 {
+		t.Errorf("expected users 2, 3, got %+v", users)
+	}
+
+	users, err = New[QUser]().SetDB(db).Select("id").WhereBitAnd("id", 1, "<>", 0).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereBitAnd(<>) failed: %v", err)
+	}
+	if len(users) != 3 || users[0].ID != 1 || users[1].ID != 3 || users[2].ID != 5 {
+		t.Errorf("expected users 1, 3, 5, got %+v", users)
+	}
+}
+
+func TestQuery_WhereEqualsFold(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	users, err := New[QUser]().SetDB(db).WhereEqualsFold("email", "U3@Example.COM").Get(context.Background())
+	if err != nil {
+		t.Fatalf("WhereEqualsFold failed: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != 3 {
+		t.Errorf("expected only user 3, got %+v", users)
+	}
+}
+
+func TestQuery_WhereDateParts(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	_, err := db.Exec(`
+		ALTER TABLE q_users ADD COLUMN created_at TEXT;
+		UPDATE q_users SET created_at = CASE id
+			WHEN 1 THEN '2023-03-05 08:00:00'
+			WHEN 2 THEN '2024-03-05 23:59:59'
+			WHEN 3 THEN '2024-03-17 12:00:00'
+			WHEN 4 THEN '2024-11-05 00:00:00'
+		END;
+	`)
+	if err != nil {
+		t.Fatalf("failed to seed created_at: %v", err)
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		q    *Model[QUser]
+		want []int
+	}{
+		{"year", New[QUser]().WhereYear("created_at", 2024), []int{2, 3, 4}},
+		{"month", New[QUser]().WhereMonth("created_at", 3), []int{1, 2, 3}},
+		{"day", New[QUser]().WhereDay("created_at", 5), []int{1, 2, 4}},
+		{"year and month", New[QUser]().WhereYear("created_at", 2024).WhereMonth("created_at", 3), []int{2, 3}},
+		{"date", New[QUser]().WhereDate("created_at", "2024-03-05"), []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := tt.q.SetDB(db).Select("id").OrderBy("id", "ASC").Get(ctx)
+			if err != nil {
+				t.Fatalf("query failed: %v", err)
+			}
+			ids := make([]int, len(users))
+			for i, u := range users {
+				ids[i] = u.ID
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("expected users %v, got %v", tt.want, ids)
+			}
+		})
+	}
+}
+
+func TestQuery_WhereContains(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO q_users (name, email) VALUES ('50% off', 'a_b@example.com'), ('500 off', 'axb@example.com')`)
+	if err != nil {
+		t.Fatalf("failed to insert rows: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// An unescaped % would also match '500 off'.
+	users, err := New[QUser]().SetDB(db).WhereContains("name", "0% o").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereContains failed: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != 6 {
+		t.Errorf("expected only user 6, got %+v", users)
+	}
+
+	// An unescaped _ would also match 'axb@'.
+	users, err = New[QUser]().SetDB(db).WhereStartsWith("email", "a_b").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereStartsWith failed: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != 6 {
+		t.Errorf("expected only user 6, got %+v", users)
+	}
+
+	count, err := New[QUser]().SetDB(db).WhereEndsWith("email", "@example.com").Count(ctx)
+	if err != nil {
+		t.Fatalf("WhereEndsWith failed: %v", err)
+	}
+	if count != 7 {
+		t.Errorf("expected 7 users, got %d", count)
+	}
+}
+
+func TestQuery_OrderByField(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	ids := []any{4, 2, 5}
+	users, err := New[QUser]().SetDB(db).WhereIn("id", ids).OrderByField("id", ids).Get(context.Background())
+	if err != nil {
+		t.Fatalf("OrderByField failed: %v", err)
+	}
+	if len(users) != 3 || users[0].ID != 4 || users[1].ID != 2 || users[2].ID != 5 {
+		t.Errorf("expected users in order 4, 2, 5, got %+v", users)
+	}
+}
+
+func TestQuery_OrderByRaw(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	// WHERE args come before ORDER BY args, so the CASE binds after them.
+	users, err := New[QUser]().SetDB(db).
+		Where("id", "<", 5).
+		OrderByRaw("CASE WHEN name = ? THEN 0 WHEN name = ? THEN 1 ELSE 2 END", "User 3", "User 1").
+		OrderBy("id", "DESC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("OrderByRaw CASE failed: %v", err)
+	}
+	var got []int
+	for _, u := range users {
+		got = append(got, u.ID)
+	}
+	if fmt.Sprint(got) != "[3 1 4 2]" {
+		t.Errorf("expected ids [3 1 4 2], got %v", got)
+	}
+
+	picked, err := New[QUser]().SetDB(db).OrderByRaw("RANDOM()").Limit(2).Get(ctx)
+	if err != nil {
+		t.Fatalf("OrderByRaw RANDOM failed: %v", err)
+	}
+	if len(picked) != 2 {
+		t.Errorf("expected 2 random users, got %d", len(picked))
+	}
+}
+
+func TestQuery_DeleteReturning(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	ctx := context.Background()
+
+	deleted, err := New[QUser]().SetDB(db).WhereIn("id", []any{2, 4}).DeleteReturning(ctx)
+	if err != nil {
+		t.Fatalf("DeleteReturning failed: %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Fatalf("expected 2 deleted rows, got %d", len(deleted))
+	}
+	byID := map[int]*QUser{}
+	for _, u := range deleted {
+		byID[u.ID] = u
+	}
+	if byID[2] == nil || byID[2].Email != "u2@example.com" || byID[4] == nil || byID[4].Name != "User 4" {
+		t.Errorf("returned rows do not match deleted rows: %+v", deleted)
+	}
+
+	var count int64
+	_ = db.QueryRow("SELECT COUNT(*) FROM q_users").Scan(&count)
+	if count != 3 {
+		t.Errorf("expected 3 users remaining, got %d", count)
+	}
+
+	if _, err := New[QUser]().SetDB(db).DeleteReturning(ctx); err == nil {
+		t.Error("expected error for DeleteReturning without WHERE")
+	}
+}
+
+func TestQuery_RawReturning(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	users, err := New[QUser]().SetDB(db).RawReturning(ctx,
+		"UPDATE q_users SET name = ? WHERE id <= ? RETURNING *", "Renamed", 2)
+	if err != nil {
+		t.Fatalf("RawReturning failed: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 returned rows, got %d", len(users))
+	}
+	for _, u := range users {
+		if u.Name != "Renamed" || u.Email == "" {
+			t.Errorf("expected updated row with email, got %+v", u)
+		}
+	}
+
+	if _, err := New[QUser]().SetDB(db).RawReturning(ctx, "UPDATE missing SET x = 1 RETURNING *"); err == nil {
+		t.Error("expected error for invalid statement")
+	}
+}
+
+type QScore struct {
+	ID       int `zorm:"primaryKey"`
+	Team     string
+	Score    int
+	TeamRank int `zorm:"column:team_rank"`
+}
+
+func (s QScore) TableName() string { return "q_scores" }
+
+func TestQuery_WithWindow(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE q_scores (id INTEGER PRIMARY KEY, team TEXT, score INTEGER);
+		INSERT INTO q_scores (team, score) VALUES
+		('red', 10), ('red', 30), ('red', 20),
+		('blue', 5), ('blue', 15);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup scores: %v", err)
+	}
+
+	scores, err := New[QScore]().SetDB(db).
+		WithWindow("team_rank", "row_number", "team", "score DESC").
+		OrderBy("id", "ASC").
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("WithWindow failed: %v", err)
+	}
+
+	want := map[int]int{1: 3, 2: 1, 3: 2, 4: 2, 5: 1}
+	if len(scores) != len(want) {
+		t.Fatalf("expected %d rows, got %d", len(want), len(scores))
+	}
+	for _, s := range scores {
+		if s.TeamRank != want[s.ID] {
+			t.Errorf("row %d: expected rank %d, got %d", s.ID, want[s.ID], s.TeamRank)
+		}
+	}
+}
+
+func TestQuery_SnapshotRestore(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	q := New[QUser]().SetDB(db).Where("id", ">", 1)
+	snap := q.Snapshot()
+
+	users, err := q.Where("name", "User 3").Get(ctx)
+	if err != nil {
+		t.Fatalf("filtered Get failed: %v", err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(users))
+	}
+
+	users, err = q.Restore(snap).Get(ctx)
+	if err != nil {
+		t.Fatalf("restored Get failed: %v", err)
+	}
+	if len(users) != 4 {
+		t.Errorf("expected 4 users after restore, got %d", len(users))
+	}
+}
+
+type QBooking struct {
+	ID       int `zorm:"primaryKey"`
+	Room     string
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+func (b QBooking) TableName() string { return "q_bookings" }
+
+func TestQuery_WhereOverlaps(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE q_bookings (id INTEGER PRIMARY KEY, room TEXT, starts_at DATETIME, ends_at DATETIME)`); err != nil {
+		t.Fatalf("failed to create bookings: %v", err)
+	}
+
+	ctx := context.Background()
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return day.Add(time.Duration(h) * time.Hour) }
+
+	bookings := []*QBooking{
+		{Room: "a", StartsAt: at(8), EndsAt: at(10)},  // ends exactly at window start
+		{Room: "b", StartsAt: at(9), EndsAt: at(11)},  // overlaps start
+		{Room: "c", StartsAt: at(11), EndsAt: at(12)}, // inside window
+		{Room: "d", StartsAt: at(13), EndsAt: at(15)}, // overlaps end
+		{Room: "e", StartsAt: at(14), EndsAt: at(16)}, // starts exactly at window end
+		{Room: "f", StartsAt: at(9), EndsAt: at(17)},  // contains window
+	}
+	for _, b := range bookings {
+		if err := New[QBooking]().SetDB(db).Create(ctx, b); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	got, err := New[QBooking]().SetDB(db).WhereOverlaps("starts_at", "ends_at", at(10), at(14)).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereOverlaps failed: %v", err)
+	}
+
+	var rooms []string
+	for _, b := range got {
+		rooms = append(rooms, b.Room)
+	}
+	if fmt.Sprint(rooms) != "[b c d f]" {
+		t.Errorf("expected rooms [b c d f] to overlap, got %v", rooms)
+	}
+}
+
+func TestQuery_WhereBetween(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	ids := func(users []*QUser) []int {
+		var out []int
+		for _, u := range users {
+			out = append(out, u.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		query *Model[QUser]
+		want  string
+	}{
+		{"inclusive bounds", New[QUser]().WhereBetween("id", 2, 4), "[2 3 4]"},
+		{"reversed bounds match nothing", New[QUser]().WhereBetween("id", 4, 2), "[]"},
+		{"not between", New[QUser]().WhereNotBetween("id", 2, 4), "[1 5]"},
+		{"or between", New[QUser]().Where("id", 1).OrWhereBetween("id", 4, 5), "[1 4 5]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := tt.query.SetDB(db).Select("id").OrderBy("id", "ASC").Get(ctx)
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			if got := fmt.Sprint(ids(users)); got != tt.want {
+				t.Errorf("expected ids %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := New[QUser]().SetDB(db).WhereBetween("id; DROP TABLE q_users", 1, 2).Get(ctx); err == nil {
+		t.Error("expected error for invalid column")
+	}
+}
+
+func TestQuery_WhereBetweenTimes(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE q_bookings (id INTEGER PRIMARY KEY, room TEXT, starts_at DATETIME, ends_at DATETIME)`); err != nil {
+		t.Fatalf("failed to create bookings: %v", err)
+	}
+
+	ctx := context.Background()
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i, room := range []string{"a", "b", "c", "d"} {
+		b := &QBooking{Room: room, StartsAt: day.Add(time.Duration(i) * time.Hour), EndsAt: day.Add(time.Duration(i+1) * time.Hour)}
+		if err := New[QBooking]().SetDB(db).Create(ctx, b); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	got, err := New[QBooking]().SetDB(db).WhereBetween("starts_at", day.Add(time.Hour), day.Add(2*time.Hour)).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereBetween failed: %v", err)
+	}
+	var rooms []string
+	for _, b := range got {
+		rooms = append(rooms, b.Room)
+	}
+	if fmt.Sprint(rooms) != "[b c]" {
+		t.Errorf("expected rooms [b c] starting within the window, got %v", rooms)
+	}
+}
+
+func TestQuery_ScanPositional(t *testing.T) {
+	db := setupQueryDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	byName, err := New[QUser]().SetDB(db).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	byPos, err := New[QUser]().SetDB(db).Select("id", "name", "email").ScanPositional().OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("ScanPositional Get failed: %v", err)
+	}
+	if len(byPos) != len(byName) {
+		t.Fatalf("expected %d rows, got %d", len(byName), len(byPos))
+	}
+	for i := range byName {
+		if *byPos[i] != *byName[i] {
+			t.Errorf("row %d: expected %+v, got %+v", i, *byName[i], *byPos[i])
+		}
+	}
+
+	// A column prefix fills the leading fields only.
+	ids, err := New[QUser]().SetDB(db).Select("id").ScanPositional().OrderBy("id", "ASC").Limit(1).Get(ctx)
+	if err != nil {
+		t.Fatalf("prefix ScanPositional failed: %v", err)
+	}
+	if len(ids) != 1 || ids[0].ID != 1 || ids[0].Name != "" {
+		t.Errorf("expected only ID=1 to be set, got %+v", ids)
+	}
+
+	_, err = New[QUser]().SetDB(db).Select("id", "name", "email", "id AS extra").ScanPositional().Get(ctx)
+	if err == nil || !strings.Contains(err.Error(), "ScanPositional") {
+		t.Errorf("expected column count error, got %v", err)
+	}
+}
+
+func BenchmarkScanPositional(b *testing.B) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE q_users (id INTEGER PRIMARY KEY, name TEXT, email TEXT)`); err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 500; i++ {
+		if _, err := db.Exec(`INSERT INTO q_users (name, email) VALUES (?, ?)`, fmt.Sprintf("User %d", i), fmt.Sprintf("u%d@example.com", i)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	ctx := context.Background()
+
+	b.Run("ByName", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := New[QUser]().SetDB(db).Select("id", "name", "email").Get(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Positional", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := New[QUser]().SetDB(db).Select("id", "name", "email").ScanPositional().Get(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -2,8 +2,15 @@ package zorm
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestSelect tests the Select method
@@ -174,6 +181,48 @@ func TestWhereIn(t *testing.T) {
 	}
 }
 
+// TestWhereInSet verifies a set becomes a sorted, deduplicated IN list and
+// that an empty set matches nothing.
+func TestWhereInSet(t *testing.T) {
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	set := map[any]struct{}{3: {}, 1: {}, int64(1): {}, 2: {}}
+	query, args := New[TestModel]().WhereInSet("id", set).Print()
+	if !strings.Contains(query, "id IN ($1,$2,$3)") {
+		t.Errorf("expected three-value IN clause, got %q", query)
+	}
+	if fmt.Sprint(args) != "[1 2 3]" {
+		t.Errorf("expected sorted args [1 2 3], got %v", args)
+	}
+
+	query, args = New[TestModel]().WhereInSet("id", map[any]struct{}{}).Print()
+	if !strings.Contains(query, "AND 1=0") || len(args) != 0 {
+		t.Errorf("expected 1=0 with no args for empty set, got %q %v", query, args)
+	}
+
+	if m := New[TestModel]().WhereInSet("id; DROP TABLE users", set); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
+// TestWhereIn_DeduplicatesValues verifies duplicate values are dropped
+// before placeholders are generated.
+func TestWhereIn_DeduplicatesValues(t *testing.T) {
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	query, args := New[TestModel]().WhereIn("id", []any{1, 1, 2}).Print()
+
+	expected := "id IN ($1,$2)"
+	if !strings.Contains(query, expected) {
+		t.Errorf("expected query to contain %q, got %q", expected, query)
+	}
+	if len(args) != 2 || args[0] != 1 || args[1] != 2 {
+		t.Errorf("expected args [1 2], got %v", args)
+	}
+}
+
 // TestWhereIn_Empty tests WhereIn with empty array
 func TestWhereIn_Empty(t *testing.T) {
 	m := New[TestModel]().WhereIn("id", []any{})
@@ -226,7 +275,7 @@ func TestWhereNotIn_SQLiteRejectsOverflow(t *testing.T) {
 	if m.buildErr == nil {
 		t.Fatalf("expected buildErr for NOT IN list of %d args, got nil", len(args))
 	}
-	if !strings.Contains(m.buildErr.Error(), "exceeds PostgreSQL parameter limit") {
+	if !strings.Contains(m.buildErr.Error(), "exceeds SQLite parameter limit 32766") {
 		t.Fatalf("expected limit error, got: %v", m.buildErr)
 	}
 }
@@ -265,6 +314,26 @@ func TestOrderBy_Multiple(t *testing.T) {
 	}
 }
 
+// TestOrderBySafe tests that only whitelisted columns are ordered on
+func TestOrderBySafe(t *testing.T) {
+	allowed := []string{"name", "created_at"}
+
+	query, _ := New[TestModel]().OrderBySafe("name", "asc", allowed).Print()
+	if !strings.Contains(query, "ORDER BY name ASC") {
+		t.Errorf("expected allowed column to be ordered, got %q", query)
+	}
+
+	query, _ = New[TestModel]().OrderBySafe("password", "ASC", allowed).Print()
+	if strings.Contains(query, "ORDER BY") {
+		t.Errorf("expected disallowed column to be ignored, got %q", query)
+	}
+
+	query, _ = New[TestModel]().OrderBySafe("id; DROP TABLE users", "ASC", allowed).OrderBy("id", "DESC").Print()
+	if !strings.Contains(query, "ORDER BY id DESC") || strings.Contains(query, "DROP") {
+		t.Errorf("expected only the default ordering, got %q", query)
+	}
+}
+
 // TestGroupBy tests the GroupBy method
 func TestGroupBy(t *testing.T) {
 	m := New[TestModel]().Select("name", "COUNT(*)").GroupBy("name")
@@ -387,6 +456,11 @@ func TestWith(t *testing.T) {
 	if m.relations[0] != "Posts" || m.relations[1] != "Comments" {
 		t.Errorf("expected relations [Posts, Comments], got %v", m.relations)
 	}
+
+	m.With("Posts")
+	if len(m.relations) != 2 {
+		t.Errorf("expected duplicate relation to be ignored, got %v", m.relations)
+	}
 }
 
 // TestWithCallback tests the WithCallback method
@@ -708,6 +782,510 @@ func TestOrWhereNotNull(t *testing.T) {
 	}
 }
 
+// TestWhereNullSafeEquals_NotDistinctFrom tests the IS NOT DISTINCT FROM
+// form used outside MySQL
+func TestWhereNullSafeEquals_NotDistinctFrom(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	for _, d := range []Dialect{DialectPostgres, DialectSQLite} {
+		SetDialect(d)
+		query, args := New[TestModel]().WhereNullSafeEquals("manager_id", nil).Print()
+
+		expected := "AND manager_id IS NOT DISTINCT FROM $1"
+		if !strings.Contains(query, expected) {
+			t.Errorf("%s: expected query to contain %q, got %q", d, expected, query)
+		}
+		if len(args) != 1 || args[0] != nil {
+			t.Errorf("%s: expected the value bound once, got %v", d, args)
+		}
+	}
+}
+
+// TestWhereNullSafeEquals_MySQL tests the native <=> operator on MySQL
+func TestWhereNullSafeEquals_MySQL(t *testing.T) {
+	SetDialect(DialectMySQL)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	query, args := New[TestModel]().WhereNullSafeEquals("manager_id", 5).Print()
+
+	if !strings.Contains(query, "manager_id <=> $1") {
+		t.Errorf("expected query to contain 'manager_id <=> $1', got %q", query)
+	}
+	if len(args) != 1 || args[0] != 5 {
+		t.Errorf("expected args [5], got %v", args)
+	}
+}
+
+// TestWhereNullSafeEquals_InvalidColumn tests column validation
+func TestWhereNullSafeEquals_InvalidColumn(t *testing.T) {
+	m := New[TestModel]().WhereNullSafeEquals("id; DROP TABLE users", 1)
+	if m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
+// TestWhereDistinctFrom tests the per-dialect NULL-aware inequality forms
+func TestWhereDistinctFrom(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectPostgres, "AND email IS DISTINCT FROM $1"},
+		{DialectSQLite, "AND email IS DISTINCT FROM $1"},
+		{DialectMySQL, "AND NOT (email <=> $1)"},
+	}
+	for _, tt := range tests {
+		SetDialect(tt.dialect)
+		for _, value := range []any{"a@example.com", nil} {
+			query, args := New[TestModel]().WhereDistinctFrom("email", value).Print()
+			if !strings.Contains(query, tt.expected) {
+				t.Errorf("%s: expected query to contain %q, got %q", tt.dialect, tt.expected, query)
+			}
+			if len(args) != 1 || args[0] != value {
+				t.Errorf("%s: expected args [%v], got %v", tt.dialect, value, args)
+			}
+		}
+	}
+
+	if m := New[TestModel]().WhereDistinctFrom("email; DROP TABLE users", 1); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
+// TestWhereCoalesce tests the COALESCE comparison and its validation
+func TestWhereCoalesce(t *testing.T) {
+	query, args := New[TestModel]().WhereCoalesce("user_age", 0, ">", 18).Print()
+	if !strings.Contains(query, "AND COALESCE(user_age, $1) > $2") {
+		t.Errorf("expected COALESCE comparison, got %q", query)
+	}
+	if len(args) != 2 || args[0] != 0 || args[1] != 18 {
+		t.Errorf("expected args [0 18], got %v", args)
+	}
+
+	if m := New[TestModel]().WhereCoalesce("user_age); DROP TABLE users; --", 0, "=", 1); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+	if m := New[TestModel]().WhereCoalesce("user_age", 0, "= 1 OR 1 =", 1); m.buildErr == nil {
+		t.Error("expected buildErr for invalid operator")
+	}
+}
+
+// TestWhereBitAnd tests the bitmask comparison and its validation
+func TestWhereBitAnd(t *testing.T) {
+	query, args := New[TestModel]().WhereBitAnd("user_age", 4, "=", 4).Print()
+	if !strings.Contains(query, "AND (user_age & $1) = $2") {
+		t.Errorf("expected bitmask comparison, got %q", query)
+	}
+	if len(args) != 2 || args[0] != 4 || args[1] != 4 {
+		t.Errorf("expected args [4 4], got %v", args)
+	}
+
+	if m := New[TestModel]().WhereBitAnd("user_age) = 0 OR (1", 1, "=", 1); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+	if m := New[TestModel]().WhereBitAnd("user_age", 1, "= 1 OR 1 =", 1); m.buildErr == nil {
+		t.Error("expected buildErr for invalid operator")
+	}
+}
+
+// TestWhereEqualsFold tests the per-dialect case-insensitive equality forms
+func TestWhereEqualsFold(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectPostgres, "WHERE 1=1  AND LOWER(email) = LOWER($1)"},
+		{DialectMySQL, "WHERE 1=1  AND LOWER(email) = LOWER($1)"},
+		{DialectSQLite, "WHERE 1=1  AND email = $1 COLLATE NOCASE"},
+	}
+	for _, tt := range tests {
+		SetDialect(tt.dialect)
+		query, args := New[TestModel]().WhereEqualsFold("email", "Alice@Example.com").Print()
+		if !strings.Contains(query, tt.expected) {
+			t.Errorf("%s: expected query to contain %q, got %q", tt.dialect, tt.expected, query)
+		}
+		if len(args) != 1 || args[0] != "Alice@Example.com" {
+			t.Errorf("%s: expected args [Alice@Example.com], got %v", tt.dialect, args)
+		}
+	}
+}
+
+// TestWhereEqualsFold_InvalidColumn tests column validation
+func TestWhereEqualsFold_InvalidColumn(t *testing.T) {
+	m := New[TestModel]().WhereEqualsFold("email; DROP TABLE users", "x")
+	if m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
+// TestWhereDateParts tests the per-dialect calendar filters
+func TestWhereDateParts(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	tests := []struct {
+		dialect  Dialect
+		expected []string
+	}{
+		{DialectPostgres, []string{
+			"AND CAST(created_at AS DATE) = $1",
+			"AND EXTRACT(YEAR FROM created_at) = $2",
+			"AND EXTRACT(MONTH FROM created_at) = $3",
+			"AND EXTRACT(DAY FROM created_at) = $4",
+		}},
+		{DialectMySQL, []string{
+			"AND DATE(created_at) = $1",
+			"AND EXTRACT(YEAR FROM created_at) = $2",
+		}},
+		{DialectSQLite, []string{
+			"AND DATE(created_at) = $1",
+			"AND CAST(strftime('%Y', created_at) AS INTEGER) = $2",
+			"AND CAST(strftime('%m', created_at) AS INTEGER) = $3",
+			"AND CAST(strftime('%d', created_at) AS INTEGER) = $4",
+		}},
+	}
+	day := time.Date(2024, 3, 5, 23, 30, 0, 0, time.UTC)
+	for _, tt := range tests {
+		SetDialect(tt.dialect)
+		query, args := New[TestModel]().
+			WhereDate("created_at", day).
+			WhereYear("created_at", 2024).
+			WhereMonth("created_at", 3).
+			WhereDay("created_at", 5).
+			Print()
+		for _, want := range tt.expected {
+			if !strings.Contains(query, want) {
+				t.Errorf("%s: expected query to contain %q, got %q", tt.dialect, want, query)
+			}
+		}
+		if len(args) != 4 || args[0] != "2024-03-05" || args[1] != 2024 || args[2] != 3 || args[3] != 5 {
+			t.Errorf("%s: expected args [2024-03-05 2024 3 5], got %v", tt.dialect, args)
+		}
+	}
+}
+
+// TestWhereDateParts_InvalidColumn tests column validation
+func TestWhereDateParts_InvalidColumn(t *testing.T) {
+	const bad = "created_at; DROP TABLE users"
+	for name, m := range map[string]*Model[TestModel]{
+		"WhereDate":  New[TestModel]().WhereDate(bad, "2024-01-01"),
+		"WhereYear":  New[TestModel]().WhereYear(bad, 2024),
+		"WhereMonth": New[TestModel]().WhereMonth(bad, 1),
+		"WhereDay":   New[TestModel]().WhereDay(bad, 1),
+	} {
+		if m.buildErr == nil {
+			t.Errorf("%s: expected buildErr for invalid column", name)
+		}
+		if len(m.wheres) != 0 {
+			t.Errorf("%s: expected the condition to be skipped, got %v", name, m.wheres)
+		}
+	}
+}
+
+// TestWhereContains tests the escaped LIKE wrappers
+func TestWhereContains(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       *Model[TestModel]
+		pattern string
+	}{
+		{"contains", New[TestModel]().WhereContains("name", `50%_off\`), `%50\%\_off\\%`},
+		{"starts with", New[TestModel]().WhereStartsWith("name", "a_b"), `a\_b%`},
+		{"ends with", New[TestModel]().WhereEndsWith("name", "100%"), `%100\%`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := tt.m.Print()
+			if !strings.Contains(query, `AND name LIKE $1 ESCAPE '\'`) {
+				t.Errorf("expected escaped LIKE, got %q", query)
+			}
+			if len(args) != 1 || args[0] != tt.pattern {
+				t.Errorf("expected pattern %q, got %v", tt.pattern, args)
+			}
+		})
+	}
+
+	if m := New[TestModel]().WhereContains("name; DROP TABLE users", "x"); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
+func TestStableOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        *Model[TestModel]
+		expected string
+	}{
+		{"appends primary key", New[TestModel]().StableOrder().OrderBy("name", "DESC"), "ORDER BY name DESC, id ASC"},
+		{"already ordered by key", New[TestModel]().StableOrder().OrderBy("name", "ASC").OrderBy("id", "DESC"), "ORDER BY name ASC, id DESC"},
+		{"qualified with joins", New[TestModel]().StableOrder().Join("roles", "roles.id", "=", "test_models.role_id").OrderBy("name", "ASC"), "ORDER BY name ASC, test_models.id ASC"},
+		{"grouped", New[TestModel]().StableOrder().GroupBy("name").OrderBy("name", "ASC"), "ORDER BY name ASC"},
+		{"without flag", New[TestModel]().OrderBy("name", "ASC"), "ORDER BY name ASC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, _ := tt.m.Print()
+			if !strings.HasSuffix(query, tt.expected) {
+				t.Errorf("expected query ending in %q, got %q", tt.expected, query)
+			}
+		})
+	}
+
+	if query, _ := New[TestModel]().StableOrder().Print(); strings.Contains(query, "ORDER BY") {
+		t.Errorf("expected no ORDER BY without OrderBy, got %q", query)
+	}
+}
+
+func TestWhereInSubquery(t *testing.T) {
+	titles := New[TestModel]().Select("name").Where("age", ">", 30)
+	sub := New[TestModel]().Select("id").Where("name", "x").WhereInSubquery("name", titles)
+	query, args := New[TestModel]().Where("age", ">", 18).WhereInSubquery("id", sub).Where("name", "y").Print()
+
+	expected := "AND id IN (SELECT id FROM test_models WHERE 1=1  AND name = $2 AND name IN (SELECT name FROM test_models WHERE 1=1  AND age > $3)) AND name = $4"
+	if !strings.Contains(query, expected) {
+		t.Errorf("expected query to contain %q, got %q", expected, query)
+	}
+	if len(args) != 4 || args[0] != 18 || args[1] != "x" || args[2] != 30 || args[3] != "y" {
+		t.Errorf("expected args [18 x 30 y], got %v", args)
+	}
+
+	query, _ = New[TestModel]().WhereNotInSubquery("id", New[TestModel]().Select("id")).Print()
+	if !strings.Contains(query, "AND id NOT IN (SELECT id FROM test_models)") {
+		t.Errorf("expected NOT IN subquery, got %q", query)
+	}
+
+	if m := New[TestModel]().WhereInSubquery("id; DROP TABLE users", sub); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+	if m := New[TestModel]().WhereInSubquery("id", "SELECT 1"); m.buildErr == nil {
+		t.Error("expected buildErr for a non-builder subquery")
+	}
+	if m := New[TestModel]().WhereInSubquery("id", New[TestModel]().Select("id; DROP")); m.buildErr == nil {
+		t.Error("expected buildErr to propagate from the subquery")
+	}
+}
+
+// TestWhereComputed tests arithmetic column expressions with a bound operand
+func TestWhereComputed(t *testing.T) {
+	query, args := New[TestModel]().WhereComputed("(stock - reserved)", "<", 5).Print()
+
+	if !strings.Contains(query, "WHERE 1=1  AND (stock - reserved) < $1") {
+		t.Errorf("expected computed condition, got %q", query)
+	}
+	if len(args) != 1 || args[0] != 5 {
+		t.Errorf("expected args [5], got %v", args)
+	}
+
+	query, _ = New[TestModel]().WhereComputed("price * 1.2 + -shipping", ">=", 100).Print()
+	if !strings.Contains(query, "AND price * 1.2 + -shipping >= $1") {
+		t.Errorf("expected computed condition, got %q", query)
+	}
+}
+
+// TestWhereComputed_Invalid tests that injectable expressions and operators are rejected
+func TestWhereComputed_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		op   string
+	}{
+		{"semicolon", "stock; DROP TABLE users", "<"},
+		{"quote", "stock - 'a'", "<"},
+		{"comment", "stock -- reserved", "<"},
+		{"adjacent identifiers", "stock UNION reserved", "<"},
+		{"function call", "lower(name)", "="},
+		{"unbalanced", "(stock - reserved", "<"},
+		{"trailing operator", "stock -", "<"},
+		{"empty", "", "<"},
+		{"keyword", "stock - select", "<"},
+		{"bad operator", "stock - reserved", "LIKE"},
+		{"operator injection", "stock", "< 1 OR 1 ="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[TestModel]().WhereComputed(tt.expr, tt.op, 1)
+			if m.buildErr == nil {
+				t.Errorf("expected buildErr for expr %q op %q", tt.expr, tt.op)
+			}
+			if len(m.wheres) != 0 || len(m.args) != 0 {
+				t.Errorf("expected no condition to be added, got %v %v", m.wheres, m.args)
+			}
+		})
+	}
+}
+
+// TestOrderByField tests the per-dialect custom ordering forms
+func TestOrderByField(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	tests := []struct {
+		dialect  Dialect
+		expected string
+		active   any
+	}{
+		{DialectPostgres, "ORDER BY CASE id WHEN $2 THEN 0 WHEN $3 THEN 1 WHEN $4 THEN 2 ELSE 3 END", true},
+		{DialectSQLite, "ORDER BY CASE id WHEN $2 THEN 0 WHEN $3 THEN 1 WHEN $4 THEN 2 ELSE 3 END", int64(1)},
+		{DialectMySQL, "ORDER BY FIELD(id, $2, $3, $4)", int64(1)},
+	}
+	for _, tt := range tests {
+		SetDialect(tt.dialect)
+		query, args := New[TestModel]().Where("active", true).OrderByField("id", []any{3, 1, 2}).Print()
+		if !strings.Contains(query, tt.expected) {
+			t.Errorf("%s: expected query to contain %q, got %q", tt.dialect, tt.expected, query)
+		}
+		if len(args) != 4 || args[0] != tt.active || args[1] != 3 || args[2] != 1 || args[3] != 2 {
+			t.Errorf("%s: expected args [%v 3 1 2], got %v", tt.dialect, tt.active, args)
+		}
+	}
+}
+
+// TestOrderByField_Invalid tests column validation and empty value lists
+func TestOrderByField_Invalid(t *testing.T) {
+	m := New[TestModel]().OrderByField("id; DROP TABLE users", []any{1})
+	if m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+
+	query, args := New[TestModel]().OrderByField("id", nil).Print()
+	if strings.Contains(query, "ORDER BY") || len(args) != 0 {
+		t.Errorf("expected no ordering for empty values, got %q %v", query, args)
+	}
+}
+
+// TestOrderByRaw tests that raw ORDER BY args are numbered after WHERE args
+// and that malformed expressions are rejected
+func TestOrderByRaw(t *testing.T) {
+	query, args := New[TestModel]().
+		Where("age", ">", 18).
+		OrderByRaw("CASE WHEN name = ? THEN 0 ELSE 1 END", "Ann").
+		OrderBy("id", "ASC").
+		Print()
+	if !strings.Contains(query, "ORDER BY CASE WHEN name = $2 THEN 0 ELSE 1 END, id ASC") {
+		t.Errorf("unexpected query: %q", query)
+	}
+	if len(args) != 2 || args[0] != 18 || args[1] != "Ann" {
+		t.Errorf("expected args [18 Ann], got %v", args)
+	}
+
+	if m := New[TestModel]().OrderByRaw("CASE WHEN name = ? THEN 0 END"); m.buildErr == nil {
+		t.Error("expected buildErr for missing args")
+	}
+	if m := New[TestModel]().OrderByRaw("  "); m.buildErr == nil {
+		t.Error("expected buildErr for empty expression")
+	}
+}
+
+// TestSelectCountFilter tests the per-dialect conditional count forms
+func TestSelectCountFilter(t *testing.T) {
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectPostgres, "SELECT team, COUNT(*) FILTER (WHERE active = $1) AS active_count, COUNT(*) FILTER (WHERE active = $2) AS inactive_count FROM"},
+		{DialectSQLite, "SELECT team, SUM(CASE WHEN active = $1 THEN 1 ELSE 0 END) AS active_count, SUM(CASE WHEN active = $2 THEN 1 ELSE 0 END) AS inactive_count FROM"},
+		{DialectMySQL, "SELECT team, SUM(CASE WHEN active = $1 THEN 1 ELSE 0 END) AS active_count, SUM(CASE WHEN active = $2 THEN 1 ELSE 0 END) AS inactive_count FROM"},
+	}
+	for _, tt := range tests {
+		SetDialect(tt.dialect)
+		query, args := New[TestModel]().Select("team").
+			SelectCountFilter("active_count", "active = ?", true).
+			SelectCountFilter("inactive_count", "active = ?", false).
+			Where("org_id", 7).
+			GroupBy("team").
+			Print()
+		if !strings.Contains(query, tt.expected) {
+			t.Errorf("%s: expected query to contain %q, got %q", tt.dialect, tt.expected, query)
+		}
+		if !strings.Contains(query, "org_id = $3") {
+			t.Errorf("%s: expected WHERE placeholder after select args, got %q", tt.dialect, query)
+		}
+		if len(args) != 3 || args[0] != true || args[1] != false || args[2] != 7 {
+			t.Errorf("%s: expected args [true false 7], got %v", tt.dialect, args)
+		}
+	}
+}
+
+// TestSelectCountFilter_Invalid tests alias, condition and arg-count validation
+func TestSelectCountFilter_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		alias     string
+		condition string
+		args      []any
+	}{
+		{"bad alias", "n; DROP TABLE users", "active = ?", []any{true}},
+		{"comment", "n", "active = ? -- x", []any{true}},
+		{"statement", "n", "active = ?; DELETE FROM users", []any{true}},
+		{"missing arg", "n", "active = ?", nil},
+		{"extra arg", "n", "active = 1", []any{true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[TestModel]().SelectCountFilter(tt.alias, tt.condition, tt.args...)
+			if m.buildErr == nil {
+				t.Error("expected buildErr")
+			}
+		})
+	}
+}
+
+// TestWithWindow tests window function columns in the SELECT list
+func TestWithWindow(t *testing.T) {
+	query, _ := New[TestModel]().WithWindow("rnk", "rank", "team", "score desc").Print()
+
+	expected := "SELECT *, RANK() OVER (PARTITION BY team ORDER BY score DESC) AS rnk FROM"
+	if !strings.Contains(query, expected) {
+		t.Errorf("expected query to contain %q, got %q", expected, query)
+	}
+
+	query, _ = New[TestModel]().Select("id").WithWindow("prev", "lag(score)", "", "id").Print()
+	expected = "SELECT id, LAG(score) OVER (ORDER BY id) AS prev FROM"
+	if !strings.Contains(query, expected) {
+		t.Errorf("expected query to contain %q, got %q", expected, query)
+	}
+}
+
+// TestWithWindow_Invalid tests WithWindow input validation
+func TestWithWindow_Invalid(t *testing.T) {
+	tests := []struct {
+		name                            string
+		alias, fn, partitionBy, orderBy string
+	}{
+		{"function not whitelisted", "x", "sum(score)", "", "id"},
+		{"lag without column", "x", "lag", "", "id"},
+		{"rank with column", "x", "rank(score)", "", "id"},
+		{"invalid alias", "x; DROP", "rank", "", "id"},
+		{"invalid partition", "x", "rank", "team, 1=1--", "id"},
+		{"invalid direction", "x", "rank", "", "id SIDEWAYS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[TestModel]().WithWindow(tt.alias, tt.fn, tt.partitionBy, tt.orderBy)
+			if m.buildErr == nil {
+				t.Error("expected buildErr")
+			}
+		})
+	}
+}
+
+// TestWhereOverlaps tests the range overlap condition and its bind order
+func TestWhereOverlaps(t *testing.T) {
+	from := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+	query, args := New[TestModel]().WhereOverlaps("starts_at", "ends_at", from, to).Print()
+
+	expected := "(starts_at < $1 AND ends_at > $2)"
+	if !strings.Contains(query, expected) {
+		t.Errorf("expected query to contain %q, got %q", expected, query)
+	}
+	if len(args) != 2 || args[0] != to || args[1] != from {
+		t.Errorf("expected args [to from], got %v", args)
+	}
+
+	if m := New[TestModel]().WhereOverlaps("starts_at; --", "ends_at", from, to); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
 // TestTable_Override tests the Table method for custom table name
 func TestTable_Override(t *testing.T) {
 	m := New[TestModel]().Table("custom_users")
@@ -801,6 +1379,79 @@ func TestWhereIn_LargeListUsesANYOnPostgres(t *testing.T) {
 	}
 }
 
+// prepareCountingDriver is a database/sql driver that records every query
+// it prepares and answers all queries with an empty result set.
+type prepareCountingDriver struct {
+	mu       sync.Mutex
+	prepared []string
+}
+
+func (d *prepareCountingDriver) Open(string) (driver.Conn, error) { return prepareCountingConn{d}, nil }
+
+type prepareCountingConn struct{ d *prepareCountingDriver }
+
+func (c prepareCountingConn) Prepare(query string) (driver.Stmt, error) {
+	c.d.mu.Lock()
+	c.d.prepared = append(c.d.prepared, query)
+	c.d.mu.Unlock()
+	return emptyResultStmt{}, nil
+}
+func (prepareCountingConn) Close() error              { return nil }
+func (prepareCountingConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type emptyResultStmt struct{}
+
+func (emptyResultStmt) Close() error                             { return nil }
+func (emptyResultStmt) NumInput() int                            { return -1 }
+func (emptyResultStmt) CheckNamedValue(*driver.NamedValue) error { return nil }
+func (emptyResultStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+func (emptyResultStmt) Query([]driver.Value) (driver.Rows, error) { return emptyRows{}, nil }
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return []string{"id"} }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+// TestWhereIn_ANYReusesCachedStatement verifies that on PostgreSQL, WhereIn
+// lists of different lengths produce the same statement text, so a
+// statement cache prepares it once.
+func TestWhereIn_ANYReusesCachedStatement(t *testing.T) {
+	drv := &prepareCountingDriver{}
+	db := sql.OpenDB(driverConnector{drv})
+	defer db.Close()
+
+	cache := NewStmtCache(10)
+	defer cache.Close()
+
+	ctx := context.Background()
+	for _, ids := range [][]any{{1, 2}, {1, 2, 3, 4, 5}} {
+		_, err := New[TestModel]().SetDB(db).SetDialect(DialectPostgres).WithStmtCache(cache).
+			WhereIn("id", ids).Get(ctx)
+		if err != nil {
+			t.Fatalf("Get with %d ids failed: %v", len(ids), err)
+		}
+	}
+
+	if len(drv.prepared) != 1 {
+		t.Fatalf("expected one prepared statement, got %d: %q", len(drv.prepared), drv.prepared)
+	}
+	if !strings.Contains(drv.prepared[0], "id = ANY($1)") {
+		t.Errorf("expected the ANY form, got %s", drv.prepared[0])
+	}
+	if cache.Len() != 1 {
+		t.Errorf("expected one cached statement, got %d", cache.Len())
+	}
+}
+
+// driverConnector adapts a driver.Driver to driver.Connector for sql.OpenDB.
+type driverConnector struct{ d driver.Driver }
+
+func (c driverConnector) Connect(context.Context) (driver.Conn, error) { return c.d.Open("") }
+func (c driverConnector) Driver() driver.Driver                        { return c.d }
+
 // TestWhereNotIn_LargeListUsesALLOnPostgres verifies that on the PostgreSQL
 // dialect WhereNotIn collapses an arbitrarily large NOT IN list to a single
 // `<> ALL($1)` placeholder backed by one typed-slice argument.
@@ -866,11 +1517,35 @@ func TestWhereIn_SQLiteRejectsOverflow(t *testing.T) {
 	if m.buildErr == nil {
 		t.Fatalf("expected buildErr for IN list of %d args, got nil", len(args))
 	}
-	if !strings.Contains(m.buildErr.Error(), "exceeds PostgreSQL parameter limit") {
+	if !strings.Contains(m.buildErr.Error(), "exceeds SQLite parameter limit 32766") {
 		t.Fatalf("expected limit error, got: %v", m.buildErr)
 	}
 }
 
+// TestWhereIn_RespectsConfiguredPlaceholderLimit verifies the IN-list guard
+// uses the dialect limit configured via SetMaxPlaceholders
+func TestWhereIn_RespectsConfiguredPlaceholderLimit(t *testing.T) {
+	SetDialect(DialectSQLite)
+	SetMaxPlaceholders(DialectSQLite, 999)
+	t.Cleanup(func() {
+		SetDialect(DialectAuto)
+		SetMaxPlaceholders(DialectSQLite, 0)
+	})
+
+	args := make([]any, 1000)
+	for i := range args {
+		args[i] = i
+	}
+	m := New[TestModel]().WhereIn("id", args)
+	if m.buildErr == nil || !strings.Contains(m.buildErr.Error(), "exceeds SQLite parameter limit 999") {
+		t.Fatalf("expected SQLite limit error, got: %v", m.buildErr)
+	}
+
+	if m := New[TestModel]().WhereIn("id", args[:999]); m.buildErr != nil {
+		t.Fatalf("expected 999 args to fit, got: %v", m.buildErr)
+	}
+}
+
 // TestWhereIn_ValidatesColumn verifies WhereIn validates column name
 func TestWhereIn_ValidatesColumn(t *testing.T) {
 	SetDialect(DialectSQLite)
@@ -1377,7 +2052,7 @@ func TestOrWhereNotIn_SQLiteRejectsOverflow(t *testing.T) {
 	if m.buildErr == nil {
 		t.Fatalf("expected buildErr for NOT IN list of %d args, got nil", len(args))
 	}
-	if !strings.Contains(m.buildErr.Error(), "exceeds PostgreSQL parameter limit") {
+	if !strings.Contains(m.buildErr.Error(), "exceeds SQLite parameter limit 32766") {
 		t.Fatalf("expected limit error, got: %v", m.buildErr)
 	}
 }
@@ -1399,3 +2074,61 @@ func TestOrWhereNotIn_ValidatesColumn(t *testing.T) {
 		t.Error("Injection attempt should be blocked")
 	}
 }
+
+// TestWithout tests removing relations together with their nested paths and options
+func TestWithout(t *testing.T) {
+	m := New[TestModel]().With("Posts", "Posts.Comments", "Profile").
+		WithCallback("Posts", func(*Model[TestModel]) {}).
+		Without("Posts")
+	if len(m.relations) != 1 || m.relations[0] != "Profile" {
+		t.Errorf("expected [Profile], got %v", m.relations)
+	}
+	if _, ok := m.relationCallbacks["Posts"]; ok {
+		t.Error("expected Posts callback to be dropped")
+	}
+
+	m = New[TestModel]().With("Posts").WithMorph("Imageable", nil).WithOnly("Posts")
+	if len(m.relations) != 1 || m.relations[0] != "Posts" {
+		t.Errorf("expected [Posts], got %v", m.relations)
+	}
+	if _, ok := m.morphRelations["Imageable"]; ok {
+		t.Error("expected Imageable morph map to be dropped")
+	}
+}
+
+// TestOrWhereGroup tests that a group is ORed in with its inner connectors
+// and args kept in order
+func TestOrWhereGroup(t *testing.T) {
+	query, args := New[TestModel]().
+		Where("a", 1).
+		OrWhereGroup(func(q *Model[TestModel]) {
+			q.Where("b", 2).OrWhere("c = ?", 3)
+		}).
+		Where("d", 4).
+		Print()
+
+	expected := "WHERE 1=1  AND a = $1 OR (b = $2 OR c = $3) AND d = $4"
+	if !strings.Contains(query, expected) {
+		t.Errorf("expected query to contain %q, got %q", expected, query)
+	}
+	if len(args) != 4 || args[0] != 1 || args[1] != 2 || args[2] != 3 || args[3] != 4 {
+		t.Errorf("expected args [1 2 3 4], got %v", args)
+	}
+
+	query, _ = New[TestModel]().
+		Where("a", 1).
+		Where(func(q *Model[TestModel]) {
+			q.Where("b", 2).OrWhere("c = ?", 3)
+		}).
+		Print()
+	if !strings.Contains(query, "AND a = $1 AND (b = $2 OR c = $3)") {
+		t.Errorf("expected the nested OR to be kept in an AND group, got %q", query)
+	}
+
+	m := New[TestModel]().OrWhereGroup(func(q *Model[TestModel]) {
+		q.OrderByField("id; DROP TABLE users", []any{1})
+	})
+	if m.buildErr == nil {
+		t.Error("expected the nested builder's error to propagate")
+	}
+}
@@ -159,3 +159,64 @@ func TestRebind_PreservesComments(t *testing.T) {
 		})
 	}
 }
+
+func TestDialect_Rebind(t *testing.T) {
+	const query = "SELECT * FROM t WHERE a = ? AND b = '?'"
+	cases := map[Dialect]string{
+		DialectAuto:     "SELECT * FROM t WHERE a = $1 AND b = '?'",
+		DialectPostgres: "SELECT * FROM t WHERE a = $1 AND b = '?'",
+		DialectSQLite:   "SELECT * FROM t WHERE a = $1 AND b = '?'",
+		DialectMySQL:    query,
+	}
+	for d, want := range cases {
+		if got := d.Rebind(query); got != want {
+			t.Errorf("%s:\n got:  %s\n want: %s", d, got, want)
+		}
+	}
+}
+
+func TestDialect_SupportsReturning(t *testing.T) {
+	for _, d := range []Dialect{DialectAuto, DialectPostgres, DialectSQLite} {
+		if !d.SupportsReturning() {
+			t.Errorf("%s: expected RETURNING support", d)
+		}
+	}
+	if DialectMySQL.SupportsReturning() {
+		t.Error("MySQL: expected no RETURNING support")
+	}
+}
+
+func TestDialect_QuoteIdentifier(t *testing.T) {
+	cases := []struct {
+		dialect Dialect
+		name    string
+		want    string
+	}{
+		{DialectPostgres, "users", `"users"`},
+		{DialectPostgres, "public.users", `"public"."users"`},
+		{DialectSQLite, `we"ird`, `"we""ird"`},
+		{DialectMySQL, "app.users", "`app`.`users`"},
+		{DialectMySQL, "we`ird", "`we``ird`"},
+	}
+	for _, tc := range cases {
+		if got := tc.dialect.QuoteIdentifier(tc.name); got != tc.want {
+			t.Errorf("%s QuoteIdentifier(%q) = %s, want %s", tc.dialect, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestModel_SetDialect(t *testing.T) {
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	m := New[TestModel]().SetDialect(DialectMySQL)
+	if got := m.effectiveDialect(); got != DialectMySQL {
+		t.Errorf("expected the model override to win, got %s", got)
+	}
+	if got := m.rebind("SELECT * FROM t WHERE id = ?"); got != "SELECT * FROM t WHERE id = ?" {
+		t.Errorf("expected MySQL placeholders, got %s", got)
+	}
+	if got := m.SetDialect(DialectAuto).effectiveDialect(); got != DialectSQLite {
+		t.Errorf("expected DialectAuto to restore the package-wide dialect, got %s", got)
+	}
+}
@@ -0,0 +1,153 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// RecordedQuery is a statement captured by a Recorder, as sent to the driver.
+type RecordedQuery struct {
+	Query string
+	Args  []any
+}
+
+// Recorder captures the SQL issued through ZORM while it is active. It is
+// meant for tests that assert which queries a code path runs.
+//
+// Recording is process-wide: queries from every model and goroutine are
+// captured, so avoid t.Parallel in tests that use it. Statements served
+// from a statement cache (WithStmtCache) are recorded without their args.
+type Recorder struct {
+	mu      sync.Mutex
+	queries []RecordedQuery
+}
+
+var (
+	recordersMu sync.RWMutex
+	recorders   []*Recorder
+	recording   atomic.Int32
+)
+
+// RecordQueries starts capturing queries into a new Recorder. Call the
+// returned function to stop; recording also stops when ctx is done.
+//
+// Example:
+//
+//	rec, stop := zorm.RecordQueries(ctx)
+//	users, err := zorm.New[User]().With("Posts").Get(ctx)
+//	stop()
+//	if err := rec.AssertQueryCount(2); err != nil {
+//	    t.Error(err)
+//	}
+func RecordQueries(ctx context.Context) (*Recorder, func()) {
+	r := &Recorder{}
+
+	recordersMu.Lock()
+	recorders = append(recorders, r)
+	recording.Add(1)
+	recordersMu.Unlock()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			recordersMu.Lock()
+			recorders = slices.DeleteFunc(recorders, func(other *Recorder) bool { return other == r })
+			recording.Add(-1)
+			recordersMu.Unlock()
+		})
+	}
+	context.AfterFunc(ctx, stop)
+	return r, stop
+}
+
+// Queries returns a copy of the queries recorded so far, in execution order.
+func (r *Recorder) Queries() []RecordedQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.queries)
+}
+
+// AssertQueryCount returns an error listing the recorded queries unless
+// exactly n were recorded.
+func (r *Recorder) AssertQueryCount(n int) error {
+	queries := r.Queries()
+	if len(queries) != n {
+		return fmt.Errorf("zorm: expected %d queries, recorded %d:%s", n, len(queries), formatRecorded(queries))
+	}
+	return nil
+}
+
+// AssertContains returns an error unless some recorded query contains substr.
+func (r *Recorder) AssertContains(substr string) error {
+	queries := r.Queries()
+	for _, q := range queries {
+		if strings.Contains(q.Query, substr) {
+			return nil
+		}
+	}
+	return fmt.Errorf("zorm: no recorded query contains %q:%s", substr, formatRecorded(queries))
+}
+
+func (r *Recorder) record(query string, args []any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = append(r.queries, RecordedQuery{Query: query, Args: slices.Clone(args)})
+}
+
+// formatRecorded renders queries one per line for assertion messages.
+func formatRecorded(queries []RecordedQuery) string {
+	var sb strings.Builder
+	for i, q := range queries {
+		fmt.Fprintf(&sb, "\n  %d: %s %v", i+1, q.Query, q.Args)
+	}
+	return sb.String()
+}
+
+// recordQuery hands query to every active Recorder.
+func recordQuery(query string, args []any) {
+	recordersMu.RLock()
+	defer recordersMu.RUnlock()
+	for _, r := range recorders {
+		r.record(query, args)
+	}
+}
+
+// queryExecutor is the subset of *sql.DB and *sql.Tx used to run queries.
+type queryExecutor interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// recordingQueryer records each statement before passing it to inner.
+type recordingQueryer struct {
+	inner queryExecutor
+}
+
+func (q recordingQueryer) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	recordQuery(query, args)
+	return q.inner.QueryContext(ctx, query, args...)
+}
+
+func (q recordingQueryer) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	recordQuery(query, args)
+	return q.inner.QueryRowContext(ctx, query, args...)
+}
+
+func (q recordingQueryer) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	recordQuery(query, args)
+	return q.inner.ExecContext(ctx, query, args...)
+}
+
+// withRecording wraps q in a recordingQueryer while any Recorder is active.
+func withRecording(q queryExecutor) queryExecutor {
+	if recording.Load() == 0 {
+		return q
+	}
+	return recordingQueryer{inner: q}
+}
@@ -0,0 +1,77 @@
+package zorm
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecordQueries_EagerLoad(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	rec, stop := RecordQueries(ctx)
+	users, err := New[RelUserExtended]().SetDB(db).With("Posts").Get(ctx)
+	stop()
+	if err != nil {
+		t.Fatalf("eager load failed: %v", err)
+	}
+	if len(users) == 0 || len(users[0].Posts) == 0 {
+		t.Fatalf("expected users with posts, got %+v", users)
+	}
+
+	// One query for the parents, one for all of their posts
+	if err := rec.AssertQueryCount(2); err != nil {
+		t.Error(err)
+	}
+	if err := rec.AssertContains("FROM rel_posts"); err != nil {
+		t.Error(err)
+	}
+	if err := rec.AssertContains("FROM rel_comments"); err == nil {
+		t.Error("expected AssertContains to fail for a query that was not run")
+	}
+
+	// Nothing is recorded after stop
+	if _, err := New[RelUserExtended]().SetDB(db).Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(rec.Queries()); got != 2 {
+		t.Errorf("expected 2 queries after stop, got %d", got)
+	}
+}
+
+func TestRecordQueries_ArgsAndContextCancel(t *testing.T) {
+	db := setupExDB(t)
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rec, stop := RecordQueries(ctx)
+	defer stop()
+
+	if _, err := New[ExModel]().SetDB(db).Where("name", "B").First(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	queries := rec.Queries()
+	if len(queries) != 1 || !strings.HasPrefix(queries[0].Query, "SELECT") || len(queries[0].Args) != 1 || queries[0].Args[0] != "B" {
+		t.Errorf("unexpected recorded queries: %+v", queries)
+	}
+
+	err := rec.AssertQueryCount(3)
+	if err == nil || !strings.Contains(err.Error(), "ex_models") {
+		t.Errorf("expected count mismatch listing the query, got %v", err)
+	}
+
+	// Cancelling ctx stops recording
+	cancel()
+	for recording.Load() != 0 { // AfterFunc runs asynchronously
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := New[ExModel]().SetDB(db).Count(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(rec.Queries()); got != 1 {
+		t.Errorf("expected recording to stop with ctx, got %d queries", got)
+	}
+}
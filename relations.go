@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // anyToKeyString converts common types to string keys efficiently.
@@ -69,6 +71,10 @@ const (
 	// RelationBelongsToMany represents a many-to-many relationship between
 	// two models, typically connected through a join table.
 	RelationBelongsToMany RelationType = "BelongsToMany"
+
+	// RelationHasManyThrough represents a one-to-many relationship reached
+	// through an intermediate table, such as Country -> User -> Post.
+	RelationHasManyThrough RelationType = "HasManyThrough"
 )
 
 // RelationDefinition holds metadata about a relation.
@@ -96,10 +102,14 @@ type HasOne[T any] struct {
 }
 
 // HasMany defines a HasMany relation.
+//
+// Set OrderBy (e.g. "created_at DESC, id DESC") to sort each parent's
+// eager-loaded children. A WithCallback OrderBy takes precedence.
 type HasMany[T any] struct {
 	ForeignKey string
 	LocalKey   string
 	Table      string
+	OrderBy    string
 }
 
 // BelongsTo defines a BelongsTo relation.
@@ -110,6 +120,14 @@ type BelongsTo[T any] struct {
 }
 
 // BelongsToMany defines a BelongsToMany relation.
+//
+// Set Timestamps when the pivot table has created_at and updated_at columns:
+// Attach then fills both with the current time, and Sync and
+// UpdateExistingPivot bump updated_at. Values passed explicitly in pivotData
+// take precedence.
+//
+// OrderBy sorts each parent's eager-loaded related rows, as for HasMany.
+// WithPivotOrderBy takes precedence over it.
 type BelongsToMany[T any] struct {
 	PivotTable string
 	ForeignKey string
@@ -117,6 +135,29 @@ type BelongsToMany[T any] struct {
 	LocalKey   string
 	RelatedPK  string
 	Table      string
+	Timestamps bool
+	OrderBy    string
+}
+
+// HasManyThrough defines a HasMany relation reached through an intermediate
+// table. Related rows are loaded with a single JOIN:
+//
+//	SELECT related.* FROM related
+//	JOIN through ON related.SecondKey = through.SecondLocalKey
+//	WHERE through.FirstKey IN (parent LocalKey values)
+//
+// Example (Country -> User -> Post):
+//
+//	func (Country) PostsRelation() HasManyThrough[Post] {
+//	    return HasManyThrough[Post]{Through: "users", FirstKey: "country_id", SecondKey: "user_id"}
+//	}
+type HasManyThrough[T any] struct {
+	Through        string // Intermediate table (e.g. users)
+	FirstKey       string // Column on Through referencing the parent; defaults to <parent>_id
+	SecondKey      string // Column on the related table referencing Through (required)
+	LocalKey       string // Parent column matched by FirstKey; defaults to the parent's primary key
+	SecondLocalKey string // Through column matched by SecondKey; defaults to id
+	Table          string
 }
 
 // MorphTo defines a polymorphic BelongsTo relation.
@@ -142,8 +183,8 @@ type BelongsToMany[T any] struct {
 //	    }
 //	}
 type MorphTo[T any] struct {
-	Type    string         // Column name for Type (e.g. imageable_type)
-	ID      string         // Column name for ID (e.g. imageable_id)
+	Type    string         // Column (or struct field) name for Type (e.g. imageable_type)
+	ID      string         // Column (or struct field) name for ID (e.g. imageable_id)
 	TypeMap map[string]any // Map of DB type string to empty struct instance (e.g. "posts": Post{})
 }
 
@@ -156,9 +197,45 @@ type MorphOne[T any] struct {
 
 // MorphMany defines a polymorphic HasMany relation.
 type MorphMany[T any] struct {
-	Type  string // Column name in related table (e.g. imageable_type)
-	ID    string // Column name in related table (e.g. imageable_id)
-	Table string
+	Type    string // Column name in related table (e.g. imageable_type)
+	ID      string // Column name in related table (e.g. imageable_id)
+	Table   string
+	OrderBy string // Default order of the loaded rows (e.g. created_at DESC)
+}
+
+// MorphToMany defines a polymorphic BelongsToMany relation: the pivot table
+// records the parent's model name in the Type column, so several parent types
+// can share it.
+//
+// Example (Post and Video both tagged through taggables):
+//
+//	func (Post) TagsRelation() MorphToMany[Tag] {
+//	    return MorphToMany[Tag]{PivotTable: "taggables", Type: "taggable_type", ID: "taggable_id"}
+//	}
+type MorphToMany[T any] struct {
+	PivotTable string
+	Type       string // Pivot column holding the parent model name (e.g. taggable_type)
+	ID         string // Pivot column referencing the parent (e.g. taggable_id)
+	RelatedKey string // Pivot column referencing the related row; defaults to <related>_id
+	RelatedPK  string
+	Table      string
+}
+
+// MorphedByMany defines the inverse of MorphToMany, loading the parents of
+// one model type from the related side.
+//
+// Example:
+//
+//	func (Tag) PostsRelation() MorphedByMany[Post] {
+//	    return MorphedByMany[Post]{PivotTable: "taggables", Type: "taggable_type", ID: "taggable_id"}
+//	}
+type MorphedByMany[T any] struct {
+	PivotTable string
+	Type       string // Pivot column holding the related model name (e.g. taggable_type)
+	ID         string // Pivot column referencing the related row (e.g. taggable_id)
+	ForeignKey string // Pivot column referencing this model; defaults to <model>_id
+	RelatedPK  string
+	Table      string
 }
 
 // Relation interface allows us to handle generics uniformly.
@@ -204,6 +281,15 @@ func (BelongsToMany[T]) NewModel(ctx context.Context, db *sql.DB) any {
 	return m
 }
 
+func (HasManyThrough[T]) RelationType() RelationType { return RelationHasManyThrough }
+func (HasManyThrough[T]) NewRelated() any            { return new(T) }
+func (HasManyThrough[T]) NewModel(ctx context.Context, db *sql.DB) any {
+	m := New[T]()
+	m.db = db
+	m.ctx = ctx
+	return m
+}
+
 const (
 	// RelationMorphTo represents a polymorphic inverse relationship where the
 	// current model can belong to one of several different model types. The
@@ -219,6 +305,14 @@ const (
 	// RelationMorphMany represents a polymorphic one-to-many relationship where
 	// multiple related records can be associated with various parent model types.
 	RelationMorphMany RelationType = "MorphMany"
+
+	// RelationMorphToMany represents a polymorphic many-to-many relationship
+	// through a pivot table shared by several parent model types.
+	RelationMorphToMany RelationType = "MorphToMany"
+
+	// RelationMorphedByMany represents the inverse of RelationMorphToMany,
+	// reaching the parents of one model type from the related side.
+	RelationMorphedByMany RelationType = "MorphedByMany"
 )
 
 // MorphTo implements Relation interface.
@@ -251,6 +345,48 @@ func (MorphMany[T]) NewModel(ctx context.Context, db *sql.DB) any {
 	return m
 }
 
+func (MorphToMany[T]) RelationType() RelationType { return RelationMorphToMany }
+func (MorphToMany[T]) NewRelated() any            { return new(T) }
+func (MorphToMany[T]) NewModel(ctx context.Context, db *sql.DB) any {
+	m := New[T]()
+	m.db = db
+	m.ctx = ctx
+	return m
+}
+
+func (MorphedByMany[T]) RelationType() RelationType { return RelationMorphedByMany }
+func (MorphedByMany[T]) NewRelated() any            { return new(T) }
+func (MorphedByMany[T]) NewModel(ctx context.Context, db *sql.DB) any {
+	m := New[T]()
+	m.db = db
+	m.ctx = ctx
+	return m
+}
+
+// tagRelation is a relation declared with a struct tag instead of a method,
+// such as zorm:"belongsTo;fk:author_id" on an Author *Author field.
+// ParseModel builds one per tagged field. Its key fields mirror
+// HasMany/BelongsTo so the eager loaders read them the same way; Table
+// comes from an optional table:name token.
+//
+// NewModel returns nil because the related type is only known at runtime,
+// so WithCallback rejects tag relations; define a relation method to
+// constrain the related query.
+type tagRelation struct {
+	Kind       RelationType
+	Related    reflect.Type
+	ForeignKey string
+	LocalKey   string
+	OwnerKey   string
+	Table      string
+}
+
+func (r tagRelation) RelationType() RelationType { return r.Kind }
+func (r tagRelation) NewRelated() any            { return reflect.New(r.Related).Interface() }
+func (r tagRelation) NewModel(ctx context.Context, db *sql.DB) any {
+	return nil
+}
+
 // TableOverrider interface allows relations to specify a custom table name.
 type TableOverrider interface {
 	GetOverrideTable() string
@@ -262,6 +398,9 @@ func (r BelongsTo[T]) GetOverrideTable() string { return r.Table }
 func (r MorphOne[T]) GetOverrideTable() string  { return r.Table }
 func (r MorphMany[T]) GetOverrideTable() string { return r.Table }
 
+func (r HasManyThrough[T]) GetOverrideTable() string { return r.Table }
+func (r tagRelation) GetOverrideTable() string       { return r.Table }
+
 // Load eager loads relations on a single entity.
 // This method creates an internal clone to avoid mutating the original model's state,
 // making it safe to reuse the model for subsequent queries.
@@ -272,6 +411,42 @@ func (m *Model[T]) Load(ctx context.Context, entity *T, relations ...string) err
 	return q.loadRelations(ctx, []*T{entity})
 }
 
+// LoadMissing loads the relations of entity whose fields are still unset,
+// skipping those already populated (e.g. by an earlier With), so no query
+// is issued for them. A relation counts as unset when its field is nil, an
+// empty slice or a zero struct. Nested relations ("Posts.Comments") are
+// decided by their root field.
+//
+// Example:
+//
+//	user, _ := Model[User]().With("Posts").Find(ctx, 1)
+//	err := Model[User]().LoadMissing(ctx, user, "Posts", "Profile") // loads Profile only
+func (m *Model[T]) LoadMissing(ctx context.Context, entity *T, relations ...string) error {
+	val := reflect.ValueOf(entity).Elem()
+	missing := make([]string, 0, len(relations))
+	for _, rel := range relations {
+		name, _, _ := strings.Cut(rel, ":")
+		name, _, _ = strings.Cut(name, ".")
+		field := m.modelInfo.GetRelationField(val, name)
+		if field.IsValid() && !relationFieldEmpty(field) {
+			continue
+		}
+		missing = append(missing, rel)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return m.Load(ctx, entity, missing...)
+}
+
+// relationFieldEmpty reports whether a relation field has not been loaded.
+func relationFieldEmpty(field reflect.Value) bool {
+	if field.Kind() == reflect.Slice {
+		return field.Len() == 0
+	}
+	return field.IsZero()
+}
+
 // LoadSlice eager loads relations on a slice of entities.
 // This method creates an internal clone to avoid mutating the original model's state,
 // making it safe to reuse the model for subsequent queries.
@@ -282,6 +457,89 @@ func (m *Model[T]) LoadSlice(ctx context.Context, entities []*T, relations ...st
 	return q.loadRelations(ctx, entities)
 }
 
+// Preload eager loads relations on entities gathered from several queries.
+// Entities sharing a primary key are loaded once and the loaded relation
+// fields are then copied to their duplicates (slices are shared, not deep
+// copied). Nil entries are skipped and an empty slice is a no-op.
+//
+// Example:
+//
+//	users := append(admins, recentlyActive...)
+//	err := Model[User]().Preload(ctx, users, "Orders", "Profile")
+func (m *Model[T]) Preload(ctx context.Context, entities []*T, relations ...string) error {
+	if len(entities) == 0 || len(relations) == 0 {
+		return nil
+	}
+	pkField, ok := m.modelInfo.Columns[m.modelInfo.PrimaryKey]
+	if !ok {
+		return m.LoadSlice(ctx, entities, relations...)
+	}
+
+	unique := make([]*T, 0, len(entities))
+	byKey := make(map[string]*T, len(entities))
+	var dups [][2]*T // {duplicate, loaded entity}
+	for _, e := range entities {
+		if e == nil {
+			continue
+		}
+		key := anyToKeyString(reflect.ValueOf(e).Elem().FieldByIndex(pkField.Index).Interface())
+		if first, seen := byKey[key]; seen {
+			if first != e {
+				dups = append(dups, [2]*T{e, first})
+			}
+			continue
+		}
+		byKey[key] = e
+		unique = append(unique, e)
+	}
+
+	if err := m.LoadSlice(ctx, unique, relations...); err != nil {
+		return err
+	}
+
+	for _, pair := range dups {
+		dst, src := reflect.ValueOf(pair[0]).Elem(), reflect.ValueOf(pair[1]).Elem()
+		for _, rel := range relations {
+			name, _, _ := strings.Cut(rel, ":")
+			name, _, _ = strings.Cut(name, ".")
+			to := m.modelInfo.GetRelationField(dst, name)
+			if to.IsValid() && to.CanSet() {
+				to.Set(m.modelInfo.GetRelationField(src, name))
+			}
+		}
+	}
+	return nil
+}
+
+// LoadSliceReport eager loads relations on a slice of entities like LoadSlice
+// and additionally reports, per relation, the indices of entities that ended
+// up with no related rows (e.g. users without orders). Only HasMany, HasOne
+// and BelongsToMany relations are reported; nested relations are not.
+//
+// Example:
+//
+//	empty, err := Model[User]().LoadSliceReport(ctx, users, "Orders")
+//	for _, i := range empty["Orders"] {
+//	    log.Printf("user %d has no orders", users[i].ID)
+//	}
+func (m *Model[T]) LoadSliceReport(ctx context.Context, entities []*T, relations ...string) (map[string][]int, error) {
+	q := m.Clone()
+	q.relations = append(q.relations, relations...)
+	q.emptyRelations = make(map[string][]int)
+	if err := q.loadRelations(ctx, entities); err != nil {
+		return nil, err
+	}
+	return q.emptyRelations, nil
+}
+
+// reportEmpty records that the parent at index i had no rows for relName
+// when loading through LoadSliceReport.
+func (m *Model[T]) reportEmpty(relName string, i int) {
+	if m.emptyRelations != nil {
+		m.emptyRelations[relName] = append(m.emptyRelations[relName], i)
+	}
+}
+
 // LoadMorph eager loads a polymorphic relation with constraints on a slice.
 // This method creates an internal clone to avoid mutating the original model's state,
 // making it safe to reuse the model for subsequent queries.
@@ -301,6 +559,11 @@ func (m *Model[T]) loadRelations(ctx context.Context, results []*T) error {
 	if len(m.relations) == 0 || len(results) == 0 {
 		return nil
 	}
+	// Bail out before issuing relation queries if the request was cancelled
+	// (or timed out) after the main query returned.
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("zorm: eager loading aborted: %w", err)
+	}
 
 	// Group relations by root
 	// Map: RootRelation -> {Cols, []SubRelations}
@@ -348,26 +611,34 @@ func (m *Model[T]) loadRelations(ctx context.Context, results []*T) error {
 	}
 
 	for relName, group := range groups {
-		// Find the method on T using cached index
 		var t T
+		if err := ctx.Err(); err != nil {
+			return WrapRelationError(relName, fmt.Sprintf("%T", t), err)
+		}
+
+		// Find the method on T using cached index
 		var methodVal reflect.Value
 
+		var relConfig any
 		if idx, ok := m.modelInfo.RelationMethods[relName]; ok {
 			methodVal = reflect.ValueOf(t).Method(idx)
 		} else if idx, ok := m.modelInfo.RelationMethods[relName+"Relation"]; ok {
 			methodVal = reflect.ValueOf(t).Method(idx)
+		} else if tagRel, ok := m.modelInfo.TagRelations[relName]; ok {
+			relConfig = tagRel
 		} else {
 			return WrapRelationError(relName, fmt.Sprintf("%T", t), ErrRelationNotFound)
 		}
 
 		// Call the method to get the relation config
-		retVals := methodVal.Call(nil)
-		if len(retVals) == 0 {
-			return fmt.Errorf("relation method %s must return a value", relName)
+		if relConfig == nil {
+			retVals := methodVal.Call(nil)
+			if len(retVals) == 0 {
+				return fmt.Errorf("relation method %s must return a value", relName)
+			}
+			relConfig = retVals[0].Interface()
 		}
 
-		relConfig := retVals[0].Interface()
-
 		// Extract callback constraints if WithCallback was used for this relation
 		var constraints *relationConstraints
 		if callback, ok := m.relationCallbacks[relName]; ok {
@@ -418,6 +689,14 @@ func (m *Model[T]) loadRelations(ctx context.Context, results []*T) error {
 				if err := m.loadBelongsToMany(ctx, results, relConfig, relName, group.Cols, group.Subs, constraints); err != nil {
 					return err
 				}
+			case RelationMorphToMany, RelationMorphedByMany:
+				if err := m.loadMorphPivot(ctx, results, relConfig, relName, group.Cols, group.Subs, constraints); err != nil {
+					return err
+				}
+			case RelationHasManyThrough:
+				if err := m.loadHasManyThrough(ctx, results, relConfig, relName, group.Cols, group.Subs, constraints); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -425,14 +704,330 @@ func (m *Model[T]) loadRelations(ctx context.Context, results []*T) error {
 	return nil
 }
 
+// relationConfig finds the relation method for relName on T (with or without
+// the "Relation" suffix) and returns the configuration it produces.
+func (m *Model[T]) relationConfig(relName string) (Relation, error) {
+	var t T
+	idx, ok := m.modelInfo.RelationMethods[relName]
+	if !ok {
+		idx, ok = m.modelInfo.RelationMethods[relName+"Relation"]
+	}
+	if !ok {
+		if tagRel, ok := m.modelInfo.TagRelations[relName]; ok {
+			return tagRel, nil
+		}
+		return nil, WrapRelationError(relName, fmt.Sprintf("%T", t), ErrRelationNotFound)
+	}
+	retVals := reflect.ValueOf(t).Method(idx).Call(nil)
+	if len(retVals) == 0 {
+		return nil, fmt.Errorf("relation method %s must return a value", relName)
+	}
+	rel, ok := retVals[0].Interface().(Relation)
+	if !ok {
+		return nil, WrapRelationError(relName, fmt.Sprintf("%T", t), ErrInvalidRelation)
+	}
+	return rel, nil
+}
+
+// loadRelationCounts runs one grouped COUNT query per WithCount relation and
+// stores the counts in each result's Attributes map as <relation>_count.
+// Parents without related rows get 0.
+func (m *Model[T]) loadRelationCounts(ctx context.Context, results []*T) error {
+	if len(m.relationCounts) == 0 || len(results) == 0 {
+		return nil
+	}
+	attrField, ok := m.modelInfo.Type.FieldByName("Attributes")
+	if !ok || attrField.Type != reflect.TypeFor[map[string]any]() {
+		return fmt.Errorf("zorm: WithCount requires an Attributes map[string]any field on %s", m.modelInfo.Type.Name())
+	}
+
+	for _, spec := range m.relationCounts {
+		relName, filter, _ := strings.Cut(spec, ":")
+		conds, condArgs, err := parseCountFilter(filter, m.effectiveDialect())
+		if err != nil {
+			return WrapRelationError(relName, m.modelInfo.Type.Name(), err)
+		}
+		attrKey := ToSnakeCase(relName) + "_count"
+		if err := loadGroupedAggregate[T, int64](ctx, m, results, attrField, "WithCount", relName, "COUNT(*)", conds, condArgs, attrKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relationAggregate is a WithSum or WithAvg request: fn (SUM or AVG) of
+// column over the related rows of relation.
+type relationAggregate struct {
+	fn       string
+	relation string
+	column   string
+}
+
+// loadRelationAggregates runs one grouped SUM or AVG query per WithSum and
+// WithAvg request and stores the results in each result's Attributes map as
+// <relation>_sum_<column> or <relation>_avg_<column>. Parents without
+// related rows get 0.
+func (m *Model[T]) loadRelationAggregates(ctx context.Context, results []*T) error {
+	if len(m.relationAggs) == 0 || len(results) == 0 {
+		return nil
+	}
+	attrField, ok := m.modelInfo.Type.FieldByName("Attributes")
+	if !ok || attrField.Type != reflect.TypeFor[map[string]any]() {
+		return fmt.Errorf("zorm: WithSum/WithAvg requires an Attributes map[string]any field on %s", m.modelInfo.Type.Name())
+	}
+
+	for _, agg := range m.relationAggs {
+		expr := agg.fn + "(" + agg.column + ")"
+		attrKey := ToSnakeCase(agg.relation) + "_" + strings.ToLower(agg.fn) + "_" + agg.column
+		if err := loadGroupedAggregate[T, float64](ctx, m, results, attrField, "WithSum/WithAvg", agg.relation, expr, nil, nil, attrKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadGroupedAggregate runs
+//
+//	SELECT column, expr FROM table WHERE column IN (...) [AND conds] GROUP BY column
+//
+// over the related rows of relName for results, and stores each parent's
+// value under attrKey in its Attributes map (attrField). Parents without
+// related rows, and NULL aggregates, get the zero V. It backs WithCount,
+// WithSum and WithAvg; method names the caller in errors.
+func loadGroupedAggregate[T, V any](ctx context.Context, m *Model[T], results []*T, attrField reflect.StructField, method, relName, expr string, conds []string, condArgs []any, attrKey string) error {
+	link, err := m.relationLinkFor(relName)
+	if err != nil {
+		return err
+	}
+	parentField, ok := m.modelInfo.Columns[link.parentColumn]
+	if !ok {
+		return fmt.Errorf("zorm: %s: column %s not found on %s", method, link.parentColumn, m.modelInfo.Type.Name())
+	}
+
+	keys := make([]string, len(results))
+	ids := make([]any, 0, len(results))
+	seen := make(map[string]bool, len(results))
+	for i, res := range results {
+		id := reflect.ValueOf(res).Elem().FieldByIndex(parentField.Index).Interface()
+		keys[i] = anyToKeyString(id)
+		if !seen[keys[i]] {
+			seen[keys[i]] = true
+			ids = append(ids, id)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	sb.WriteString(link.column)
+	sb.WriteString(", ")
+	sb.WriteString(expr)
+	sb.WriteString(" FROM ")
+	sb.WriteString(link.table)
+	sb.WriteString(" WHERE ")
+	inFrag, args, err := buildInClause(link.column, ids, m.effectiveDialect())
+	if err != nil {
+		return err
+	}
+	sb.WriteString(inFrag)
+	for _, cond := range conds {
+		sb.WriteString(" AND ")
+		sb.WriteString(cond)
+	}
+	args = append(args, condArgs...)
+	sb.WriteString(" GROUP BY ")
+	sb.WriteString(link.column)
+
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]V, len(ids))
+	for rows.Next() {
+		var key any
+		var v sql.Null[V]
+		if err := rows.Scan(&key, &v); err != nil {
+			rows.Close()
+			return err
+		}
+		values[anyToKeyString(key)] = v.V
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i, res := range results {
+		attrs := reflect.ValueOf(res).Elem().FieldByIndex(attrField.Index)
+		if attrs.IsNil() {
+			attrs.Set(reflect.MakeMap(attrs.Type()))
+		}
+		attrs.SetMapIndex(reflect.ValueOf(attrKey), reflect.ValueOf(values[keys[i]]))
+	}
+	return nil
+}
+
+// parseCountFilter parses the "col=value,col2=value2" filter of a WithCount
+// relation into "col = ?" conditions. Values that parse as integers or as
+// true/false are bound as such; anything else is bound as a string.
+func parseCountFilter(filter string, d Dialect) ([]string, []any, error) {
+	if filter == "" {
+		return nil, nil, nil
+	}
+	var conds []string
+	var args []any
+	for _, pair := range strings.Split(filter, ",") {
+		col, raw, ok := strings.Cut(pair, "=")
+		col = strings.TrimSpace(col)
+		if !ok || col == "" {
+			return nil, nil, fmt.Errorf("%w: WithCount filter %q must be col=value", ErrInvalidConfig, pair)
+		}
+		if err := ValidateColumnName(col); err != nil {
+			return nil, nil, err
+		}
+		raw = strings.TrimSpace(raw)
+		var val any = raw
+		if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			val = n
+		} else if raw == "true" || raw == "false" {
+			val = encodeBool(raw == "true", d)
+		}
+		conds = append(conds, col+" = ?")
+		args = append(args, val)
+	}
+	return conds, args, nil
+}
+
+// relationLink describes how a relation's table joins back to the parent:
+// table.column = parentTable.parentColumn. countColumn identifies one related
+// row (its primary key, or the related key of a pivot row).
+type relationLink struct {
+	table        string
+	column       string
+	parentColumn string
+	countColumn  string
+}
+
+// relationLinkFor resolves relName's join keys from the relation config with
+// the same defaults the eager loaders use. Polymorphic relations are not
+// supported.
+func (m *Model[T]) relationLinkFor(relName string) (relationLink, error) {
+	rel, err := m.relationConfig(relName)
+	if err != nil {
+		return relationLink{}, err
+	}
+
+	valConfig := reflect.ValueOf(rel)
+	if valConfig.Kind() == reflect.Ptr {
+		valConfig = valConfig.Elem()
+	}
+	field := func(name string) string {
+		if f := valConfig.FieldByName(name); f.IsValid() {
+			return f.String()
+		}
+		return ""
+	}
+
+	parentKey := m.modelInfo.PrimaryKey
+	if localKey := field("LocalKey"); localKey != "" {
+		parentKey = localKey
+	}
+
+	var link relationLink
+	switch rel.RelationType() {
+	case RelationHasOne, RelationHasMany:
+		relatedInfo := ParseModelType(reflect.TypeOf(rel.NewRelated()).Elem())
+		link.table = relatedInfo.TableName
+		link.column = field("ForeignKey")
+		if link.column == "" {
+			link.column = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
+		}
+		link.parentColumn = parentKey
+		link.countColumn = relatedInfo.PrimaryKey
+	case RelationBelongsTo:
+		relatedInfo := ParseModelType(reflect.TypeOf(rel.NewRelated()).Elem())
+		link.table = relatedInfo.TableName
+		link.column = field("OwnerKey")
+		if link.column == "" {
+			link.column = relatedInfo.PrimaryKey
+		}
+		link.parentColumn = field("ForeignKey")
+		if link.parentColumn == "" {
+			link.parentColumn = ToSnakeCase(relName) + "_id"
+		}
+		link.countColumn = link.column
+	case RelationBelongsToMany:
+		link.table = field("PivotTable")
+		if link.table == "" {
+			return relationLink{}, WrapRelationError(relName, "pivot", ErrInvalidConfig)
+		}
+		link.column = field("ForeignKey")
+		if link.column == "" {
+			link.column = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
+		}
+		link.parentColumn = parentKey
+		link.countColumn = field("RelatedKey")
+		if link.countColumn == "" {
+			link.countColumn = ToSnakeCase(reflect.TypeOf(rel.NewRelated()).Elem().Name()) + "_id"
+		}
+	default:
+		return relationLink{}, fmt.Errorf("zorm: relation %s of type %s is not supported here", relName, rel.RelationType())
+	}
+	if rel.RelationType() != RelationBelongsToMany {
+		if override := field("Table"); override != "" {
+			link.table = override
+		}
+	}
+
+	for _, ident := range []string{link.table, link.column, link.parentColumn, link.countColumn} {
+		if err := ValidateColumnName(ident); err != nil {
+			return relationLink{}, fmt.Errorf("zorm: relation %s: %w", relName, err)
+		}
+	}
+	return link, nil
+}
+
+// relationExistsSubquery builds a correlated EXISTS (SELECT 1 ...) expression
+// that is true when the current row has at least one related row for relName.
+func (m *Model[T]) relationExistsSubquery(relName string) (string, error) {
+	link, err := m.relationLinkFor(relName)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("EXISTS (SELECT 1 FROM ")
+	sb.WriteString(link.table)
+	sb.WriteString(" WHERE ")
+	sb.WriteString(link.table)
+	sb.WriteByte('.')
+	sb.WriteString(link.column)
+	sb.WriteString(" = ")
+	sb.WriteString(m.TableName())
+	sb.WriteByte('.')
+	sb.WriteString(link.parentColumn)
+	sb.WriteByte(')')
+	return sb.String(), nil
+}
+
+// morphFieldName resolves a MorphTo Type or ID setting to a struct field
+// name. The setting may name the field ("ImageableType") or its column
+// ("imageable_type").
+func morphFieldName(info *ModelInfo, name string) string {
+	if field, ok := info.Columns[name]; ok {
+		return field.Name
+	}
+	return name
+}
+
 func (m *Model[T]) loadMorphTo(ctx context.Context, results []*T, relConfig any, relName string, typeMap map[string][]string) error {
 	// 1. Get Type and ID fields from MorphTo config
 	morphRel, ok := relConfig.(MorphTo[any])
 	if !ok {
 		return fmt.Errorf("relation %s: expected MorphTo[any], got %T", relName, relConfig)
 	}
-	typeField := morphRel.Type
-	idField := morphRel.ID
+	// Type and ID may hold either struct field names or column names.
+	typeField := morphFieldName(m.modelInfo, morphRel.Type)
+	idField := morphFieldName(m.modelInfo, morphRel.ID)
 
 	// 2. Group IDs by Type
 	// Map: Type -> []ID
@@ -444,24 +1039,8 @@ func (m *Model[T]) loadMorphTo(ctx context.Context, results []*T, relConfig any,
 		val := reflect.ValueOf(res).Elem()
 
 		// Get Type
-		// Actually, `typeField` in MorphTo is usually the DB column name.
-		// We need the struct field name.
-		// Let's assume strict convention or we need to find the field.
-		// For now, assume struct field name is CamelCase of typeField (e.g. "imageable_type" -> "ImageableType")
-		// Or we use `FieldByName` if it exists.
-
-		// Better: Use ModelInfo to find field by column name?
-		// Or just assume the user passed the Struct Field Name in MorphTo config?
-		// The definition `Type: "ImageableType"` is better.
-
-		// Let's try to find the field.
 		tf := val.FieldByName(typeField)
 		if !tf.IsValid() {
-			// Try converting snake_case to PascalCase
-			// "imageable_type" -> "ImageableType"
-			// Simple conversion for now.
-			// TODO: Robust conversion
-			// For now assume user put Struct Field Name in MorphTo definition.
 			continue
 		}
 
@@ -549,7 +1128,7 @@ func (m *Model[T]) loadMorphTo(ctx context.Context, results []*T, relConfig any,
 		sb.WriteString(" WHERE ")
 		sb.WriteString(inFrag)
 
-		rows, err := m.queryer().QueryContext(ctx, rebind(sb.String()), args...)
+		rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
 		if err != nil {
 			return err
 		}
@@ -645,8 +1224,13 @@ func (m *Model[T]) loadHasMany(ctx context.Context, results []*T, relConfig any,
 	// Extract Table Name
 	relTable := valConfig.FieldByName("Table").String()
 
+	order, err := relationOrder(valConfig)
+	if err != nil {
+		return err
+	}
+
 	// Use shared helper
-	relatedResults, err := m.loadRelationQuery(ctx, relatedInfo, foreignKey, ids, cols, relTable, constraints)
+	relatedResults, err := m.loadRelationQuery(ctx, relatedInfo, foreignKey, ids, cols, relTable, withDefaultOrder(constraints, order))
 	if err != nil {
 		return err
 	}
@@ -677,22 +1261,14 @@ func (m *Model[T]) loadHasMany(ctx context.Context, results []*T, relConfig any,
 		parentVal := reflect.ValueOf(parent).Elem()
 		parentID := ids[i]
 
-		if children, ok := relatedMap[parentID]; ok {
-			relField := m.modelInfo.GetRelationField(parentVal, relName)
-			if relField.IsValid() && relField.CanSet() {
-				sliceType := relField.Type()
-				slice := reflect.MakeSlice(sliceType, 0, len(children))
-
-				for _, child := range children {
-					if sliceType.Elem().Kind() == reflect.Pointer {
-						slice = reflect.Append(slice, child)
-					} else {
-						slice = reflect.Append(slice, child.Elem())
-					}
-				}
-
-				relField.Set(slice)
-			}
+		children, ok := relatedMap[parentID]
+		if !ok {
+			m.reportEmpty(relName, i)
+			continue
+		}
+		relField := m.modelInfo.GetRelationField(parentVal, relName)
+		if relField.IsValid() && relField.CanSet() {
+			setRelatedField(relField, children)
 		}
 	}
 
@@ -710,7 +1286,6 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 	foreignKey := valConfig.FieldByName("ForeignKey").String()
 	relatedKey := valConfig.FieldByName("RelatedKey").String()
 	localKey := valConfig.FieldByName("LocalKey").String()
-	relatedPK := valConfig.FieldByName("RelatedPK").String()
 
 	if pivotTable == "" {
 		return fmt.Errorf("BelongsToMany requires PivotTable")
@@ -760,6 +1335,92 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 		relatedKey = ToSnakeCase(relatedType.Name()) + "_id"
 	}
 
+	spec := pivotSpec{table: pivotTable, foreignKey: foreignKey, relatedKey: relatedKey}
+	return m.loadViaPivot(ctx, results, ids, relConfig, relName, cols, subRelations, constraints, spec)
+}
+
+// loadMorphPivot eager loads a MorphToMany or MorphedByMany relation. The
+// morph type stored in the pivot is the parent's struct name for
+// MorphToMany and the related struct name for MorphedByMany, matching
+// MorphOne and MorphMany.
+func (m *Model[T]) loadMorphPivot(ctx context.Context, results []*T, relConfig any, relName string, cols string, subRelations []string, constraints *relationConstraints) error {
+	rel, ok := relConfig.(Relation)
+	if !ok {
+		return fmt.Errorf("invalid relation config")
+	}
+	valConfig := reflect.ValueOf(relConfig)
+	if valConfig.Kind() == reflect.Ptr {
+		valConfig = valConfig.Elem()
+	}
+
+	pivotTable := valConfig.FieldByName("PivotTable").String()
+	typeColumn := valConfig.FieldByName("Type").String()
+	idColumn := valConfig.FieldByName("ID").String()
+	if pivotTable == "" || typeColumn == "" || idColumn == "" {
+		return fmt.Errorf("MorphToMany/MorphedByMany requires PivotTable, Type and ID columns")
+	}
+
+	relatedType := reflect.TypeOf(rel.NewRelated()).Elem()
+	spec := pivotSpec{table: pivotTable, morphType: typeColumn}
+	if rel.RelationType() == RelationMorphToMany {
+		spec.foreignKey = idColumn
+		spec.relatedKey = valConfig.FieldByName("RelatedKey").String()
+		if spec.relatedKey == "" {
+			spec.relatedKey = ToSnakeCase(relatedType.Name()) + "_id"
+		}
+		spec.morphValue = m.modelInfo.Type.Name()
+	} else {
+		spec.foreignKey = valConfig.FieldByName("ForeignKey").String()
+		if spec.foreignKey == "" {
+			spec.foreignKey = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
+		}
+		spec.relatedKey = idColumn
+		spec.morphValue = relatedType.Name()
+	}
+
+	// Validate identifiers to prevent SQL injection
+	for _, ident := range []string{spec.table, spec.foreignKey, spec.relatedKey, spec.morphType} {
+		if err := ValidateColumnName(ident); err != nil {
+			return fmt.Errorf("invalid %s identifier %q: %w", relName, ident, err)
+		}
+	}
+
+	ids := make([]any, len(results))
+	pkFieldInfo, hasPKField := m.modelInfo.Columns[m.modelInfo.PrimaryKey]
+	for i, res := range results {
+		val := reflect.ValueOf(res).Elem()
+		if hasPKField {
+			ids[i] = val.FieldByIndex(pkFieldInfo.Index).Interface()
+		} else {
+			ids[i] = val.FieldByName("ID").Interface()
+		}
+	}
+
+	return m.loadViaPivot(ctx, results, ids, relConfig, relName, cols, subRelations, constraints, spec)
+}
+
+// pivotSpec describes how a many-to-many relation reaches its related rows
+// through a pivot table.
+type pivotSpec struct {
+	table      string
+	foreignKey string // Pivot column matched against the parent keys
+	relatedKey string // Pivot column holding the related keys
+	morphType  string // Optional pivot column restricting rows to morphValue
+	morphValue string
+}
+
+// loadViaPivot loads the related rows of a many-to-many relation for the
+// parents in results, whose keys are ids, and assigns them to relName.
+// It serves BelongsToMany, MorphToMany and MorphedByMany, whose configs all
+// carry RelatedPK and Table.
+func (m *Model[T]) loadViaPivot(ctx context.Context, results []*T, ids []any, relConfig any, relName string, cols string, subRelations []string, constraints *relationConstraints, spec pivotSpec) error {
+	valConfig := reflect.ValueOf(relConfig)
+	if valConfig.Kind() == reflect.Ptr {
+		valConfig = valConfig.Elem()
+	}
+	relatedPK := valConfig.FieldByName("RelatedPK").String()
+	pivotTable, foreignKey, relatedKey := spec.table, spec.foreignKey, spec.relatedKey
+
 	// 3. Query Pivot Table to get Related IDs
 	// SELECT foreign_key, related_key FROM pivot_table WHERE foreign_key IN (...)
 	var pivotSb strings.Builder
@@ -770,13 +1431,25 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 	pivotSb.WriteString(" FROM ")
 	pivotSb.WriteString(pivotTable)
 	pivotSb.WriteString(" WHERE ")
-	inFrag, args, err := buildInClause(foreignKey, ids, m.effectiveDialect())
+	var args []any
+	if spec.morphType != "" {
+		pivotSb.WriteString(spec.morphType)
+		pivotSb.WriteString(" = ? AND ")
+		args = append(args, spec.morphValue)
+	}
+	inFrag, inArgs, err := buildInClause(foreignKey, ids, m.effectiveDialect())
 	if err != nil {
 		return err
 	}
 	pivotSb.WriteString(inFrag)
+	args = append(args, inArgs...)
+	// Pivot row order drives the order children are assigned in (step 6).
+	if order, ok := m.pivotOrders[relName]; ok {
+		pivotSb.WriteString(" ORDER BY ")
+		pivotSb.WriteString(order)
+	}
 
-	rows, err := m.queryer().QueryContext(ctx, rebind(pivotSb.String()), args...)
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(pivotSb.String()), args...)
 	if err != nil {
 		return err
 	}
@@ -807,13 +1480,16 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 	}
 
 	if len(allRelatedIDs) == 0 {
+		for i := range results {
+			m.reportEmpty(relName, i)
+		}
 		return nil
 	}
 
 	// 4. Query Related Model
 	rel, ok := relConfig.(Relation)
 	if !ok {
-		return fmt.Errorf("BelongsToMany: expected Relation interface, got %T", relConfig)
+		return fmt.Errorf("%s: expected Relation interface, got %T", relName, relConfig)
 	}
 	relatedPtr := rel.NewRelated()
 	relatedType := reflect.TypeOf(relatedPtr).Elem()
@@ -828,6 +1504,12 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 	// Extract Table Name if overriden
 	relTable := valConfig.FieldByName("Table").String()
 
+	order, err := relationOrder(valConfig)
+	if err != nil {
+		return err
+	}
+	constraints = withDefaultOrder(constraints, order)
+
 	relatedResults, err := m.loadRelationQuery(ctx, relatedInfo, joinKey, allRelatedIDs, cols, relTable, constraints)
 	if err != nil {
 		return err
@@ -853,6 +1535,9 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 		rKey := anyToKeyString(rID)
 		relatedIdxMap[rKey] = reflect.ValueOf(res)
 	}
+	if _, pivotOrdered := m.pivotOrders[relName]; !pivotOrdered && constraints != nil && len(constraints.orderBys) > 0 {
+		sortByRelatedOrder(pivotMap, relatedResults, joinKeyFieldInfo)
+	}
 
 	for i, parent := range results {
 		parentVal := reflect.ValueOf(parent).Elem()
@@ -861,6 +1546,7 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 
 		rIDs, found := pivotMap[parentKey]
 		if !found {
+			m.reportEmpty(relName, i)
 			continue
 		}
 
@@ -871,7 +1557,9 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 			}
 		}
 
-		if len(children) > 0 {
+		if len(children) == 0 {
+			m.reportEmpty(relName, i)
+		} else {
 			relField := m.modelInfo.GetRelationField(parentVal, relName)
 			if relField.IsValid() && relField.CanSet() {
 				sliceType := relField.Type()
@@ -892,6 +1580,224 @@ func (m *Model[T]) loadBelongsToMany(ctx context.Context, results []*T, relConfi
 	return nil
 }
 
+func (m *Model[T]) loadHasManyThrough(ctx context.Context, results []*T, relConfig any, relName string, cols string, subRelations []string, constraints *relationConstraints) error {
+	parents := make([]any, len(results))
+	for i, res := range results {
+		parents[i] = res
+	}
+	empty, err := m.loadThrough(ctx, parents, m.modelInfo, relConfig, relName, cols, subRelations, constraints)
+	if err != nil {
+		return err
+	}
+	for _, i := range empty {
+		m.reportEmpty(relName, i)
+	}
+	return nil
+}
+
+func (m *Model[T]) loadHasManyThroughDynamic(ctx context.Context, results []any, modelType reflect.Type, relConfig any, relName string, cols string, subRelations []string) error {
+	_, err := m.loadThrough(ctx, results, ParseModelType(modelType), relConfig, relName, cols, subRelations, nil)
+	return err
+}
+
+// throughKeyAlias names the extra column loadThrough selects to map related
+// rows back to their parents.
+const throughKeyAlias = "zorm_through_key"
+
+// loadThrough loads a HasManyThrough relation for parents (pointers to
+// structs described by modelInfo) and returns the indices of parents that
+// got no related rows.
+func (m *Model[T]) loadThrough(ctx context.Context, parents []any, modelInfo *ModelInfo, relConfig any, relName string, cols string, subRelations []string, constraints *relationConstraints) ([]int, error) {
+	rel, ok := relConfig.(Relation)
+	if !ok {
+		return nil, fmt.Errorf("HasManyThrough: expected Relation interface, got %T", relConfig)
+	}
+	valConfig := reflect.ValueOf(relConfig)
+	if valConfig.Kind() == reflect.Ptr {
+		valConfig = valConfig.Elem()
+	}
+
+	through := valConfig.FieldByName("Through").String()
+	firstKey := valConfig.FieldByName("FirstKey").String()
+	secondKey := valConfig.FieldByName("SecondKey").String()
+	localKey := valConfig.FieldByName("LocalKey").String()
+	secondLocalKey := valConfig.FieldByName("SecondLocalKey").String()
+
+	if through == "" || secondKey == "" {
+		return nil, fmt.Errorf("HasManyThrough requires Through and SecondKey")
+	}
+	if firstKey == "" {
+		firstKey = ToSnakeCase(modelInfo.Type.Name()) + "_id"
+	}
+	if localKey == "" {
+		localKey = modelInfo.PrimaryKey
+	}
+	if secondLocalKey == "" {
+		secondLocalKey = "id"
+	}
+
+	relatedType := reflect.TypeOf(rel.NewRelated()).Elem()
+	relatedInfo := ParseModelType(relatedType)
+	relTable := valConfig.FieldByName("Table").String()
+	if relTable == "" {
+		relTable = relatedInfo.TableName
+	}
+
+	for _, ident := range []string{through, firstKey, secondKey, localKey, secondLocalKey, relTable} {
+		if err := ValidateColumnName(ident); err != nil {
+			return nil, WrapRelationError(relName, modelInfo.Type.Name(), err)
+		}
+	}
+
+	localField, ok := modelInfo.Columns[localKey]
+	if !ok {
+		return nil, fmt.Errorf("HasManyThrough: local key column %s not found on %s", localKey, modelInfo.Type.Name())
+	}
+	ids := make([]any, len(parents))
+	for i, parent := range parents {
+		ids[i] = reflect.ValueOf(parent).Elem().FieldByIndex(localField.Index).Interface()
+	}
+
+	// SELECT related.*, through.first_key AS zorm_through_key FROM related
+	// JOIN through ON related.second_key = through.second_local_key
+	// WHERE through.first_key IN (...)
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	if cols != "" {
+		for i, col := range strings.Split(cols, ",") {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			col = strings.TrimSpace(col)
+			if !strings.Contains(col, ".") {
+				sb.WriteString(relTable)
+				sb.WriteByte('.')
+			}
+			sb.WriteString(col)
+		}
+	} else {
+		sb.WriteString(relTable)
+		sb.WriteString(".*")
+	}
+	sb.WriteString(", ")
+	sb.WriteString(through)
+	sb.WriteByte('.')
+	sb.WriteString(firstKey)
+	sb.WriteString(" AS ")
+	sb.WriteString(throughKeyAlias)
+	sb.WriteString(" FROM ")
+	sb.WriteString(relTable)
+	sb.WriteString(" JOIN ")
+	sb.WriteString(through)
+	sb.WriteString(" ON ")
+	sb.WriteString(relTable)
+	sb.WriteByte('.')
+	sb.WriteString(secondKey)
+	sb.WriteString(" = ")
+	sb.WriteString(through)
+	sb.WriteByte('.')
+	sb.WriteString(secondLocalKey)
+	sb.WriteString(" WHERE ")
+	inFrag, args, err := buildInClause(through+"."+firstKey, ids, m.effectiveDialect())
+	if err != nil {
+		return nil, err
+	}
+	sb.WriteString(inFrag)
+
+	if constraints != nil {
+		writeConstraintWheres(&sb, constraints.wheres)
+		args = append(args, constraints.args...)
+
+		if len(constraints.orderBys) > 0 {
+			sb.WriteString(" ORDER BY ")
+			sb.WriteString(strings.Join(constraints.orderBys, ", "))
+			args = append(args, constraints.orderArgs...)
+		}
+		if constraints.limit > 0 {
+			sb.WriteString(" LIMIT ")
+			sb.WriteString(strconv.Itoa(constraints.limit))
+		}
+	}
+
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]*FieldInfo, len(columns))
+	for i, colName := range columns {
+		fields[i] = relatedInfo.Columns[colName]
+	}
+
+	var relatedResults []any
+	var parentKeys []string
+	dest := make([]any, len(columns))
+	for rows.Next() {
+		val := reflect.New(relatedType)
+		elem := val.Elem()
+		var throughKey any
+		for i, f := range fields {
+			switch {
+			case columns[i] == throughKeyAlias:
+				dest[i] = &throughKey
+			case f != nil:
+				dest[i] = elem.FieldByIndex(f.Index).Addr().Interface()
+			default:
+				var ignore any
+				dest[i] = &ignore
+			}
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		relatedResults = append(relatedResults, val.Interface())
+		parentKeys = append(parentKeys, anyToKeyString(throughKey))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(subRelations) > 0 && len(relatedResults) > 0 {
+		if err := m.loadRelationsDynamic(ctx, relatedResults, relatedType, subRelations); err != nil {
+			return nil, err
+		}
+	}
+
+	childrenByParent := make(map[string][]reflect.Value, len(parents))
+	for i, res := range relatedResults {
+		childrenByParent[parentKeys[i]] = append(childrenByParent[parentKeys[i]], reflect.ValueOf(res))
+	}
+
+	var empty []int
+	for i, parent := range parents {
+		children, found := childrenByParent[anyToKeyString(ids[i])]
+		if !found {
+			empty = append(empty, i)
+			continue
+		}
+		relField := modelInfo.GetRelationField(reflect.ValueOf(parent).Elem(), relName)
+		if !relField.IsValid() || !relField.CanSet() || relField.Kind() != reflect.Slice {
+			continue
+		}
+		sliceType := relField.Type()
+		slice := reflect.MakeSlice(sliceType, 0, len(children))
+		for _, child := range children {
+			if sliceType.Elem().Kind() == reflect.Pointer {
+				slice = reflect.Append(slice, child)
+			} else {
+				slice = reflect.Append(slice, child.Elem())
+			}
+		}
+		relField.Set(slice)
+	}
+	return empty, nil
+}
+
 func (m *Model[T]) loadBelongsTo(ctx context.Context, results []*T, relConfig any, relName string, cols string, subRelations []string, constraints *relationConstraints) error {
 	// 1. Get FKs from results (Parent.ForeignKey)
 	valConfig := reflect.ValueOf(relConfig)
@@ -1043,10 +1949,11 @@ func (m *Model[T]) loadBelongsTo(ctx context.Context, results []*T, relConfig an
 
 // relationConstraints holds additional query constraints extracted from a WithCallback callback.
 type relationConstraints struct {
-	wheres   []string
-	args     []any
-	orderBys []string
-	limit    int
+	wheres    []string
+	args      []any
+	orderBys  []string
+	orderArgs []any
+	limit     int
 }
 
 // extractRelationConstraints creates a model for the related type, applies the callback,
@@ -1096,6 +2003,13 @@ func extractRelationConstraints(rel Relation, callback any, ctx context.Context,
 			}
 		}
 	}
+	if getOrderArgs := modelReflect.MethodByName("GetOrderArgs"); getOrderArgs.IsValid() {
+		if result := getOrderArgs.Call(nil); len(result) > 0 {
+			if oas, ok := result[0].Interface().([]any); ok {
+				rc.orderArgs = oas
+			}
+		}
+	}
 	if getLimit := modelReflect.MethodByName("GetLimit"); getLimit.IsValid() {
 		if result := getLimit.Call(nil); len(result) > 0 {
 			rc.limit = int(result[0].Int())
@@ -1105,6 +2019,111 @@ func extractRelationConstraints(rel Relation, callback any, ctx context.Context,
 	return rc
 }
 
+// writeConstraintWheres appends the WHERE conditions of a WithCallback
+// callback after the relation's key IN (...) filter. They are grouped in
+// parentheses so an OrWhere in the callback cannot match rows of other
+// parents.
+func writeConstraintWheres(sb *strings.Builder, wheres []string) {
+	if len(wheres) == 0 {
+		return
+	}
+	sb.WriteString(" AND (1=1")
+	for _, w := range wheres {
+		sb.WriteString(" ")
+		sb.WriteString(w)
+	}
+	sb.WriteByte(')')
+}
+
+// relationOrder returns the validated OrderBy of a relation config: a
+// comma-separated list of columns, each optionally followed by ASC or DESC.
+// It returns "" when the relation has no OrderBy.
+func relationOrder(valConfig reflect.Value) (string, error) {
+	field := valConfig.FieldByName("OrderBy")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return "", nil
+	}
+	order := strings.TrimSpace(field.String())
+	if order == "" {
+		return "", nil
+	}
+
+	terms := strings.Split(order, ",")
+	for i, term := range terms {
+		col, dir, _ := strings.Cut(strings.TrimSpace(term), " ")
+		if err := ValidateColumnName(col); err != nil {
+			return "", fmt.Errorf("invalid relation order column %q: %w", col, err)
+		}
+		dir = strings.ToUpper(strings.TrimSpace(dir))
+		if dir != "" && dir != "ASC" && dir != "DESC" {
+			return "", fmt.Errorf("invalid relation order direction %q", dir)
+		}
+		terms[i] = strings.TrimSpace(col + " " + dir)
+	}
+	return strings.Join(terms, ", "), nil
+}
+
+// withDefaultOrder applies a relation's OrderBy unless the WithCallback
+// constraints already order the related rows.
+func withDefaultOrder(constraints *relationConstraints, order string) *relationConstraints {
+	if order == "" || (constraints != nil && len(constraints.orderBys) > 0) {
+		return constraints
+	}
+	var rc relationConstraints
+	if constraints != nil {
+		rc = *constraints
+	}
+	rc.orderBys = []string{order}
+	return &rc
+}
+
+// sortByRelatedOrder reorders each parent's pivot keys to follow the order
+// the related rows were loaded in, so an ordered related query also orders
+// BelongsToMany children.
+func sortByRelatedOrder(pivotMap map[string][]string, relatedResults []any, joinKeyField *FieldInfo) {
+	rank := make(map[string]int, len(relatedResults))
+	for i, res := range relatedResults {
+		rID := reflect.ValueOf(res).Elem().FieldByIndex(joinKeyField.Index).Interface()
+		rank[anyToKeyString(rID)] = i
+	}
+	for _, keys := range pivotMap {
+		sort.SliceStable(keys, func(i, j int) bool { return rank[keys[i]] < rank[keys[j]] })
+	}
+}
+
+// withRequiredColumns appends any of the required key columns missing from a
+// "relation:cols" column list, so eager loading can still map related rows
+// back to their parents when the caller didn't select the key. An empty cols
+// (SELECT *) or a list containing * is returned unchanged.
+func withRequiredColumns(cols string, required ...string) string {
+	if cols == "" {
+		return cols
+	}
+	selected := strings.Split(cols, ",")
+	for i, c := range selected {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c == "*" || strings.HasSuffix(c, ".*") {
+			return cols
+		}
+		selected[i] = c
+	}
+	for _, key := range required {
+		lowerKey := strings.ToLower(key)
+		found := false
+		for _, c := range selected {
+			if c == lowerKey || strings.HasSuffix(c, "."+lowerKey) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			cols += ", " + key
+			selected = append(selected, lowerKey)
+		}
+	}
+	return cols
+}
+
 // loadRelationQuery executes a SELECT * FROM table WHERE key IN (ids)
 func (m *Model[T]) loadRelationQuery(ctx context.Context, relatedInfo *ModelInfo, key string, ids []any, cols string, tableName string, constraints *relationConstraints) ([]any, error) {
 	// Validate column name to prevent SQL injection
@@ -1115,7 +2134,7 @@ func (m *Model[T]) loadRelationQuery(ctx context.Context, relatedInfo *ModelInfo
 	var sb strings.Builder
 	sb.WriteString("SELECT ")
 	if cols != "" {
-		sb.WriteString(cols)
+		sb.WriteString(withRequiredColumns(cols, key))
 	} else {
 		sb.WriteString("*")
 	}
@@ -1134,15 +2153,13 @@ func (m *Model[T]) loadRelationQuery(ctx context.Context, relatedInfo *ModelInfo
 
 	// Apply callback constraints
 	if constraints != nil {
-		for _, w := range constraints.wheres {
-			sb.WriteString(" ")
-			sb.WriteString(w)
-		}
+		writeConstraintWheres(&sb, constraints.wheres)
 		args = append(args, constraints.args...)
 
 		if len(constraints.orderBys) > 0 {
 			sb.WriteString(" ORDER BY ")
 			sb.WriteString(strings.Join(constraints.orderBys, ", "))
+			args = append(args, constraints.orderArgs...)
 		}
 		if constraints.limit > 0 {
 			sb.WriteString(" LIMIT ")
@@ -1150,7 +2167,7 @@ func (m *Model[T]) loadRelationQuery(ctx context.Context, relatedInfo *ModelInfo
 		}
 	}
 
-	rows, err := m.queryer().QueryContext(ctx, rebind(sb.String()), args...)
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -1226,10 +2243,16 @@ func (m *Model[T]) loadMorphOneOrMany(ctx context.Context, results []*T, relConf
 		tableName = relTable
 	}
 
+	order, err := relationOrder(valConfig)
+	if err != nil {
+		return err
+	}
+	constraints = withDefaultOrder(constraints, order)
+
 	var sb strings.Builder
 	sb.WriteString("SELECT ")
 	if cols != "" {
-		sb.WriteString(cols)
+		sb.WriteString(withRequiredColumns(cols, idColumn))
 	} else {
 		sb.WriteString("*")
 	}
@@ -1250,15 +2273,13 @@ func (m *Model[T]) loadMorphOneOrMany(ctx context.Context, results []*T, relConf
 
 	// Apply callback constraints
 	if constraints != nil {
-		for _, w := range constraints.wheres {
-			sb.WriteString(" ")
-			sb.WriteString(w)
-		}
+		writeConstraintWheres(&sb, constraints.wheres)
 		args = append(args, constraints.args...)
 
 		if len(constraints.orderBys) > 0 {
 			sb.WriteString(" ORDER BY ")
 			sb.WriteString(strings.Join(constraints.orderBys, ", "))
+			args = append(args, constraints.orderArgs...)
 		}
 		if constraints.limit > 0 {
 			sb.WriteString(" LIMIT ")
@@ -1267,7 +2288,7 @@ func (m *Model[T]) loadMorphOneOrMany(ctx context.Context, results []*T, relConf
 	}
 
 	// Execute
-	rows, err := m.queryer().QueryContext(ctx, rebind(sb.String()), args...)
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
 	if err != nil {
 		return err
 	}
@@ -1378,11 +2399,16 @@ func (m *Model[T]) loadRelationsDynamic(ctx context.Context, results []any, mode
 	}
 
 	for relName, group := range groups {
+		if err := ctx.Err(); err != nil {
+			return WrapRelationError(relName, modelType.String(), err)
+		}
+
 		// Find method on modelType
 		// The relation might return *Related or Related.
 		// We want []*Related.
 		// relatedType is Related (struct type).
 		ptrType := reflect.PointerTo(modelType)
+		var tagRel tagRelation
 		method, ok := ptrType.MethodByName(relName)
 		if !ok {
 			method, ok = modelType.MethodByName(relName)
@@ -1392,7 +2418,10 @@ func (m *Model[T]) loadRelationsDynamic(ctx context.Context, results []any, mode
 				if !ok {
 					method, ok = modelType.MethodByName(relName + "Relation")
 					if !ok {
-						return fmt.Errorf("relation method %s not found on %v", relName, modelType)
+						tagRel, ok = ParseModelType(modelType).TagRelations[relName]
+						if !ok {
+							return fmt.Errorf("relation method %s not found on %v", relName, modelType)
+						}
 					}
 				}
 			}
@@ -1402,9 +2431,12 @@ func (m *Model[T]) loadRelationsDynamic(ctx context.Context, results []any, mode
 			return nil
 		}
 
-		res0 := reflect.ValueOf(results[0])
-		retVals := method.Func.Call([]reflect.Value{res0})
-		relConfig := retVals[0].Interface()
+		var relConfig any = tagRel
+		if method.Func.IsValid() {
+			res0 := reflect.ValueOf(results[0])
+			retVals := method.Func.Call([]reflect.Value{res0})
+			relConfig = retVals[0].Interface()
+		}
 
 		if rel, ok := relConfig.(Relation); ok {
 			switch rel.RelationType() {
@@ -1420,6 +2452,10 @@ func (m *Model[T]) loadRelationsDynamic(ctx context.Context, results []any, mode
 				if err := m.loadBelongsToManyDynamic(ctx, results, modelType, relConfig, relName, group.Cols, group.Subs); err != nil {
 					return err
 				}
+			case RelationHasManyThrough:
+				if err := m.loadHasManyThroughDynamic(ctx, results, modelType, relConfig, relName, group.Cols, group.Subs); err != nil {
+					return err
+				}
 			case RelationMorphOne:
 				if err := m.loadMorphOneOrManyDynamic(ctx, results, modelType, relConfig, relName, group.Cols, group.Subs, true); err != nil {
 					return err
@@ -1466,7 +2502,7 @@ func (m *Model[T]) loadHasManyDynamic(ctx context.Context, results []any, modelT
 	var sb strings.Builder
 	sb.WriteString("SELECT ")
 	if cols != "" {
-		sb.WriteString(cols)
+		sb.WriteString(withRequiredColumns(cols, foreignKey))
 	} else {
 		sb.WriteString("*")
 	}
@@ -1477,10 +2513,19 @@ func (m *Model[T]) loadHasManyDynamic(ctx context.Context, results []any, modelT
 	if err != nil {
 		return err
 	}
-	sb.WriteString(inFrag)
+	sb.WriteString(inFrag)
+
+	order, err := relationOrder(valConfig)
+	if err != nil {
+		return err
+	}
+	if order != "" {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(order)
+	}
 
 	// Execute
-	rows, err := m.queryer().QueryContext(ctx, rebind(sb.String()), args...)
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
 	if err != nil {
 		return err
 	}
@@ -1514,16 +2559,7 @@ func (m *Model[T]) loadHasManyDynamic(ctx context.Context, results []any, modelT
 		if children, ok := relatedMap[parentID]; ok {
 			relField := modelInfo.GetRelationField(parentVal, relName)
 			if relField.IsValid() && relField.CanSet() {
-				sliceType := relField.Type()
-				slice := reflect.MakeSlice(sliceType, 0, len(children))
-				for _, child := range children {
-					if sliceType.Elem().Kind() == reflect.Ptr {
-						slice = reflect.Append(slice, child)
-					} else {
-						slice = reflect.Append(slice, child.Elem())
-					}
-				}
-				relField.Set(slice)
+				setRelatedField(relField, children)
 			}
 		}
 	}
@@ -1531,6 +2567,32 @@ func (m *Model[T]) loadHasManyDynamic(ctx context.Context, results []any, modelT
 	return nil
 }
 
+// setRelatedField assigns loaded children to a HasMany or HasOne field.
+// Slice fields receive every child; pointer and struct fields (HasOne)
+// receive the first one.
+func setRelatedField(relField reflect.Value, children []reflect.Value) {
+	if len(children) == 0 {
+		return
+	}
+	switch relField.Kind() {
+	case reflect.Slice:
+		sliceType := relField.Type()
+		slice := reflect.MakeSlice(sliceType, 0, len(children))
+		for _, child := range children {
+			if sliceType.Elem().Kind() == reflect.Pointer {
+				slice = reflect.Append(slice, child)
+			} else {
+				slice = reflect.Append(slice, child.Elem())
+			}
+		}
+		relField.Set(slice)
+	case reflect.Pointer:
+		relField.Set(children[0])
+	case reflect.Struct:
+		relField.Set(children[0].Elem())
+	}
+}
+
 func (m *Model[T]) loadBelongsToDynamic(ctx context.Context, results []any, modelType reflect.Type, relConfig any, relName string, cols string, subRelations []string) error {
 	modelInfo := ParseModelType(modelType)
 
@@ -1734,7 +2796,7 @@ func (m *Model[T]) loadBelongsToManyDynamic(ctx context.Context, results []any,
 	}
 	pivotSb.WriteString(inFrag)
 
-	rows, err := m.queryer().QueryContext(ctx, rebind(pivotSb.String()), args...)
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(pivotSb.String()), args...)
 	if err != nil {
 		return err
 	}
@@ -1779,7 +2841,11 @@ func (m *Model[T]) loadBelongsToManyDynamic(ctx context.Context, results []any,
 	}
 
 	relTable := valConfig.FieldByName("Table").String()
-	relatedResults, err := m.loadRelationQuery(ctx, relatedInfo, joinKey, allRelatedIDs, cols, relTable, nil)
+	order, err := relationOrder(valConfig)
+	if err != nil {
+		return err
+	}
+	relatedResults, err := m.loadRelationQuery(ctx, relatedInfo, joinKey, allRelatedIDs, cols, relTable, withDefaultOrder(nil, order))
 	if err != nil {
 		return err
 	}
@@ -1801,6 +2867,9 @@ func (m *Model[T]) loadBelongsToManyDynamic(ctx context.Context, results []any,
 		rKey := anyToKeyString(rID)
 		relatedIdxMap[rKey] = reflect.ValueOf(res)
 	}
+	if order != "" {
+		sortByRelatedOrder(pivotMap, relatedResults, joinKeyFieldInfo)
+	}
 
 	for i, parent := range results {
 		parentVal := reflect.ValueOf(parent).Elem()
@@ -1885,7 +2954,7 @@ func (m *Model[T]) loadMorphOneOrManyDynamic(ctx context.Context, results []any,
 	var sb strings.Builder
 	sb.WriteString("SELECT ")
 	if cols != "" {
-		sb.WriteString(cols)
+		sb.WriteString(withRequiredColumns(cols, idColumn))
 	} else {
 		sb.WriteString("*")
 	}
@@ -1904,7 +2973,16 @@ func (m *Model[T]) loadMorphOneOrManyDynamic(ctx context.Context, results []any,
 	args = append(args, parentType)
 	args = append(args, inArgs...)
 
-	rows, err := m.queryer().QueryContext(ctx, rebind(sb.String()), args...)
+	order, err := relationOrder(valConfig)
+	if err != nil {
+		return err
+	}
+	if order != "" {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(order)
+	}
+
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(sb.String()), args...)
 	if err != nil {
 		return err
 	}
@@ -1972,66 +3050,12 @@ func (m *Model[T]) Attach(ctx context.Context, entity *T, relation string, ids [
 		return nil
 	}
 
-	// 1. Get Relation Config
-	var t T
-	methodVal := reflect.ValueOf(t).MethodByName(relation)
-	if !methodVal.IsValid() {
-		methodVal = reflect.ValueOf(t).MethodByName(relation + "Relation")
-		if !methodVal.IsValid() {
-			return fmt.Errorf("relation method %s not found", relation)
-		}
-	}
-	retVals := methodVal.Call(nil)
-	relConfig := retVals[0].Interface()
-
-	// Check if it's a BelongsToMany struct
-	valConfig := reflect.ValueOf(relConfig)
-	if valConfig.Kind() == reflect.Ptr {
-		valConfig = valConfig.Elem()
-	}
-	if !strings.Contains(valConfig.Type().String(), "BelongsToMany") {
-		return WrapRelationError(relation, fmt.Sprintf("%T", t), ErrInvalidRelation)
-	}
-	pivotTable := valConfig.FieldByName("PivotTable").String()
-	foreignKey := valConfig.FieldByName("ForeignKey").String()
-	relatedKey := valConfig.FieldByName("RelatedKey").String()
-
-	if pivotTable == "" {
-		return WrapRelationError(relation, "pivot", ErrInvalidConfig)
-	}
-
-	// Validate relation identifiers to prevent SQL injection
-	if err := ValidateColumnName(pivotTable); err != nil {
-		return fmt.Errorf("invalid pivot table name: %w", err)
-	}
-
-	// 2. Get Parent ID
-	parentVal := reflect.ValueOf(entity).Elem()
-	pkField := m.modelInfo.PrimaryKey
-	var parentID any
-	if field, ok := m.modelInfo.Columns[pkField]; ok {
-		// Use FieldByIndex for access instead of FieldByName O(n)
-		parentID = parentVal.FieldByIndex(field.Index).Interface()
-	} else {
-		parentID = parentVal.FieldByName("ID").Interface()
-	}
-
-	if foreignKey == "" {
-		foreignKey = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
-	}
-	if relatedKey == "" {
-		return WrapRelationError(relation, "pivot", ErrInvalidConfig)
-	}
-
-	// Validate key columns
-	if err := ValidateColumnName(foreignKey); err != nil {
-		return fmt.Errorf("invalid foreign key name: %w", err)
-	}
-	if err := ValidateColumnName(relatedKey); err != nil {
-		return fmt.Errorf("invalid related key name: %w", err)
+	pivotTable, foreignKey, relatedKey, parentID, timestamps, err := m.pivotRelation(entity, relation)
+	if err != nil {
+		return err
 	}
 
-	// 3. Bulk Insert
+	// Bulk Insert
 	// We need to collect all columns first to ensure consistency
 	// Base columns: foreignKey, relatedKey
 	// Additional columns from pivotData
@@ -2044,6 +3068,12 @@ func (m *Model[T]) Attach(ctx context.Context, entity *T, relation string, ids [
 		}
 	}
 
+	if timestamps {
+		pivotColsMap["created_at"] = true
+		pivotColsMap["updated_at"] = true
+	}
+	now := encodeTime(time.Now(), m.timeLayout())
+
 	var extraCols []string
 	for k := range pivotColsMap {
 		// Validate extra column names
@@ -2080,149 +3110,120 @@ func (m *Model[T]) Attach(ctx context.Context, entity *T, relation string, ids [
 		// Add extra values
 		for _, col := range extraCols {
 			var val any
+			var explicit bool
 			if pivotData != nil {
 				if data, ok := pivotData[id]; ok {
-					val = data[col]
+					val, explicit = data[col]
 				}
 			}
+			if !explicit && timestamps && (col == "created_at" || col == "updated_at") {
+				val = now
+			}
 			args = append(args, val)
 		}
 	}
 
-	_, err := m.queryer().ExecContext(ctx, rebind(sb.String()), args...)
+	_, err = m.queryer().ExecContext(ctx, m.rebind(sb.String()), args...)
 	return err
 }
 
-// Detach deletes rows from the pivot table.
-func (m *Model[T]) Detach(ctx context.Context, entity *T, relation string, ids []any) error {
-	// 1. Get Relation Config (Same as Attach)
-	var t T
-	methodVal := reflect.ValueOf(t).MethodByName(relation)
-	if !methodVal.IsValid() {
-		methodVal = reflect.ValueOf(t).MethodByName(relation + "Relation")
-		if !methodVal.IsValid() {
-			return fmt.Errorf("relation method %s not found", relation)
-		}
-	}
-	retVals := methodVal.Call(nil)
-	relConfig := retVals[0].Interface()
-
-	valConfig := reflect.ValueOf(relConfig)
-	if valConfig.Kind() == reflect.Ptr {
-		valConfig = valConfig.Elem()
-	}
-	if valConfig.Type().Name() != "BelongsToMany" {
-		// Strict check might fail if package name included? "zorm.BelongsToMany"
-		if !strings.Contains(valConfig.Type().String(), "BelongsToMany") {
-			return fmt.Errorf("relation %s is not BelongsToMany", relation)
-		}
-	}
-
-	pivotTable := valConfig.FieldByName("PivotTable").String()
-	foreignKey := valConfig.FieldByName("ForeignKey").String()
-	relatedKey := valConfig.FieldByName("RelatedKey").String()
-
-	if pivotTable == "" {
-		return fmt.Errorf("pivot table not defined")
+// UpdateExistingPivot updates the extra columns of the pivot row linking
+// entity to relatedID, e.g. a role or expiry stored on the association.
+// Other pivot rows are left untouched. When the relation has Timestamps set,
+// updated_at is bumped unless pivotData sets it explicitly.
+func (m *Model[T]) UpdateExistingPivot(ctx context.Context, entity *T, relation string, relatedID any, pivotData map[string]any) error {
+	pivotTable, foreignKey, relatedKey, parentID, timestamps, err := m.pivotRelation(entity, relation)
+	if err != nil {
+		return err
 	}
 
-	// Validate relation identifiers to prevent SQL injection
-	if err := ValidateColumnName(pivotTable); err != nil {
-		return fmt.Errorf("invalid pivot table name: %w", err)
+	values := make(map[string]any, len(pivotData)+1)
+	for col, val := range pivotData {
+		values[col] = val
 	}
-
-	// 2. Get Parent ID
-	parentVal := reflect.ValueOf(entity).Elem()
-	pkField := m.modelInfo.PrimaryKey
-	var parentID any
-	if field, ok := m.modelInfo.Columns[pkField]; ok {
-		// Use FieldByIndex for access instead of FieldByName O(n)
-		parentID = parentVal.FieldByIndex(field.Index).Interface()
-	} else {
-		parentID = parentVal.FieldByName("ID").Interface()
+	if _, ok := values["updated_at"]; timestamps && !ok {
+		values["updated_at"] = encodeTime(time.Now(), m.timeLayout())
 	}
-
-	if foreignKey == "" {
-		foreignKey = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
+	if len(values) == 0 {
+		return nil
 	}
 
-	// Validate foreign key column
-	if err := ValidateColumnName(foreignKey); err != nil {
-		return fmt.Errorf("invalid foreign key name: %w", err)
+	// Sort columns so the generated SQL is deterministic
+	cols := make([]string, 0, len(values))
+	for col := range values {
+		if err := ValidateColumnName(col); err != nil {
+			return fmt.Errorf("invalid pivot column name %q: %w", col, err)
+		}
+		cols = append(cols, col)
 	}
+	sort.Strings(cols)
 
-	// 3. Delete
 	var sb strings.Builder
-	sb.WriteString("DELETE FROM ")
+	sb.WriteString("UPDATE ")
 	sb.WriteString(pivotTable)
+	sb.WriteString(" SET ")
+	args := make([]any, 0, len(cols)+2)
+	for i, col := range cols {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(col)
+		sb.WriteString(" = ?")
+		args = append(args, values[col])
+	}
 	sb.WriteString(" WHERE ")
 	sb.WriteString(foreignKey)
+	sb.WriteString(" = ? AND ")
+	sb.WriteString(relatedKey)
 	sb.WriteString(" = ?")
-	args := []any{parentID}
+	args = append(args, parentID, relatedID)
 
-	if len(ids) > 0 {
-		if relatedKey == "" {
-			return fmt.Errorf("RelatedKey must be defined for Detach with IDs")
-		}
-		// Validate related key column
-		if err := ValidateColumnName(relatedKey); err != nil {
-			return fmt.Errorf("invalid related key name: %w", err)
-		}
-		sb.WriteString(" AND ")
-		inFrag, inArgs, err := buildInClause(relatedKey, ids, m.effectiveDialect())
-		if err != nil {
-			return err
-		}
-		sb.WriteString(inFrag)
-		args = append(args, inArgs...)
-	}
-
-	_, err := m.queryer().ExecContext(ctx, rebind(sb.String()), args...)
+	_, err = m.queryer().ExecContext(ctx, m.rebind(sb.String()), m.bindBools(args)...)
 	return err
 }
 
-// Sync synchronizes the association with the given IDs.
-// It attaches missing IDs and detaches IDs that are not in the new list.
-// pivotData: map[any]map[string]any (RelatedID -> {Column: Value})
-func (m *Model[T]) Sync(ctx context.Context, entity *T, relation string, ids []any, pivotData map[any]map[string]any) error {
-	// 1. Get Relation Config
+// pivotRelation resolves the pivot table, key columns and parent ID of a
+// BelongsToMany relation for entity, validating every identifier, and
+// whether the pivot table keeps timestamps. Keys left empty on the relation
+// default as in eager loading. Attach, Detach, Sync, Toggle and
+// UpdateExistingPivot all resolve the relation through it.
+func (m *Model[T]) pivotRelation(entity *T, relation string) (pivotTable, foreignKey, relatedKey string, parentID any, timestamps bool, err error) {
 	var t T
 	methodVal := reflect.ValueOf(t).MethodByName(relation)
 	if !methodVal.IsValid() {
-		// Try with "Relation" suffix
 		methodVal = reflect.ValueOf(t).MethodByName(relation + "Relation")
 		if !methodVal.IsValid() {
-			return fmt.Errorf("relation method %s not found", relation)
+			return "", "", "", nil, false, fmt.Errorf("relation method %s not found", relation)
 		}
 	}
 	retVals := methodVal.Call(nil)
 	relConfig := retVals[0].Interface()
 
+	// Check if it's a BelongsToMany struct
 	valConfig := reflect.ValueOf(relConfig)
 	if valConfig.Kind() == reflect.Ptr {
 		valConfig = valConfig.Elem()
 	}
 	if !strings.Contains(valConfig.Type().String(), "BelongsToMany") {
-		return fmt.Errorf("relation %s is not BelongsToMany", relation)
+		return "", "", "", nil, false, WrapRelationError(relation, fmt.Sprintf("%T", t), ErrInvalidRelation)
 	}
-
-	pivotTable := valConfig.FieldByName("PivotTable").String()
-	foreignKey := valConfig.FieldByName("ForeignKey").String()
-	relatedKey := valConfig.FieldByName("RelatedKey").String()
+	pivotTable = valConfig.FieldByName("PivotTable").String()
+	foreignKey = valConfig.FieldByName("ForeignKey").String()
+	relatedKey = valConfig.FieldByName("RelatedKey").String()
+	timestamps = valConfig.FieldByName("Timestamps").Bool()
 
 	if pivotTable == "" {
-		return fmt.Errorf("pivot table not defined")
+		return "", "", "", nil, false, WrapRelationError(relation, "pivot", ErrInvalidConfig)
 	}
 
 	// Validate relation identifiers to prevent SQL injection
 	if err := ValidateColumnName(pivotTable); err != nil {
-		return fmt.Errorf("invalid pivot table name: %w", err)
+		return "", "", "", nil, false, fmt.Errorf("invalid pivot table name: %w", err)
 	}
 
-	// 2. Get Parent ID
+	// Get Parent ID
 	parentVal := reflect.ValueOf(entity).Elem()
 	pkField := m.modelInfo.PrimaryKey
-	var parentID any
 	if field, ok := m.modelInfo.Columns[pkField]; ok {
 		// Use FieldByIndex for access instead of FieldByName O(n)
 		parentID = parentVal.FieldByIndex(field.Index).Interface()
@@ -2234,46 +3235,75 @@ func (m *Model[T]) Sync(ctx context.Context, entity *T, relation string, ids []a
 		foreignKey = ToSnakeCase(m.modelInfo.Type.Name()) + "_id"
 	}
 	if relatedKey == "" {
-		return fmt.Errorf("RelatedKey must be defined for Sync")
+		// Same default as loadBelongsToMany
+		rel, ok := relConfig.(Relation)
+		if !ok {
+			return "", "", "", nil, false, WrapRelationError(relation, "pivot", ErrInvalidConfig)
+		}
+		relatedKey = ToSnakeCase(reflect.TypeOf(rel.NewRelated()).Elem().Name()) + "_id"
 	}
 
 	// Validate key columns
 	if err := ValidateColumnName(foreignKey); err != nil {
-		return fmt.Errorf("invalid foreign key name: %w", err)
+		return "", "", "", nil, false, fmt.Errorf("invalid foreign key name: %w", err)
 	}
 	if err := ValidateColumnName(relatedKey); err != nil {
-		return fmt.Errorf("invalid related key name: %w", err)
+		return "", "", "", nil, false, fmt.Errorf("invalid related key name: %w", err)
+	}
+
+	return pivotTable, foreignKey, relatedKey, parentID, timestamps, nil
+}
+
+// Detach deletes rows from the pivot table.
+func (m *Model[T]) Detach(ctx context.Context, entity *T, relation string, ids []any) error {
+	pivotTable, foreignKey, relatedKey, parentID, _, err := m.pivotRelation(entity, relation)
+	if err != nil {
+		return err
 	}
 
-	// 3. Get Current IDs
 	var sb strings.Builder
-	sb.WriteString("SELECT ")
-	sb.WriteString(relatedKey)
-	sb.WriteString(" FROM ")
+	sb.WriteString("DELETE FROM ")
 	sb.WriteString(pivotTable)
 	sb.WriteString(" WHERE ")
 	sb.WriteString(foreignKey)
 	sb.WriteString(" = ?")
-	query := sb.String()
-	rows, err := m.queryer().QueryContext(ctx, rebind(query), parentID)
+	args := []any{parentID}
+
+	if len(ids) > 0 {
+		sb.WriteString(" AND ")
+		inFrag, inArgs, err := buildInClause(relatedKey, ids, m.effectiveDialect())
+		if err != nil {
+			return err
+		}
+		sb.WriteString(inFrag)
+		args = append(args, inArgs...)
+	}
+
+	_, err = m.queryer().ExecContext(ctx, m.rebind(sb.String()), args...)
+	return err
+}
+
+// Sync synchronizes the association with the given IDs.
+// It attaches missing IDs and detaches IDs that are not in the new list.
+// When the relation has Timestamps set, IDs that stay attached are updated
+// with their pivotData entry and have updated_at bumped.
+// pivotData: map[any]map[string]any (RelatedID -> {Column: Value})
+func (m *Model[T]) Sync(ctx context.Context, entity *T, relation string, ids []any, pivotData map[any]map[string]any) error {
+	pivotTable, foreignKey, relatedKey, parentID, timestamps, err := m.pivotRelation(entity, relation)
 	if err != nil {
 		return err
 	}
-	defer rows.Close()
 
-	currentIDs := make(map[string]any) // string key -> original value
-	for rows.Next() {
-		var id any
-		if err := rows.Scan(&id); err != nil {
-			return err
-		}
-		key := anyToKeyString(id)
-		currentIDs[key] = id
+	// Get Current IDs
+	currentIDs, err := m.currentPivotIDs(ctx, pivotTable, foreignKey, relatedKey, parentID)
+	if err != nil {
+		return err
 	}
 
-	// 4. Determine Attach and Detach
+	// Determine Attach and Detach
 	var toAttach []any
 	var toDetach []any
+	var toTouch []any
 
 	// Normalize input IDs to map for lookup
 	newIDsMap := make(map[string]any, len(ids)) // string key -> original value
@@ -2286,6 +3316,8 @@ func (m *Model[T]) Sync(ctx context.Context, entity *T, relation string, ids []a
 	for key, id := range newIDsMap {
 		if _, exists := currentIDs[key]; !exists {
 			toAttach = append(toAttach, id)
+		} else if timestamps {
+			toTouch = append(toTouch, id)
 		}
 	}
 
@@ -2296,7 +3328,7 @@ func (m *Model[T]) Sync(ctx context.Context, entity *T, relation string, ids []a
 		}
 	}
 
-	// 5. Execute
+	// Execute
 	if len(toDetach) > 0 {
 		if err := m.Detach(ctx, entity, relation, toDetach); err != nil {
 			return err
@@ -2309,5 +3341,104 @@ func (m *Model[T]) Sync(ctx context.Context, entity *T, relation string, ids []a
 		}
 	}
 
+	// Kept rows get their updated_at bumped along with any pivot data
+	for _, id := range toTouch {
+		if err := m.UpdateExistingPivot(ctx, entity, relation, id, pivotData[id]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// Toggle attaches the given IDs that are not currently associated and
+// detaches the ones that are. It returns the IDs that were attached and
+// detached. Both steps run in one transaction, opened here unless the model
+// is already bound to one.
+// pivotData: map[any]map[string]any (RelatedID -> {Column: Value})
+func (m *Model[T]) Toggle(ctx context.Context, entity *T, relation string, ids []any, pivotData map[any]map[string]any) (attached []any, detached []any, err error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	pivotTable, foreignKey, relatedKey, parentID, _, err := m.pivotRelation(entity, relation)
+	if err != nil {
+		return nil, nil, err
+	}
+	if m.tx == nil {
+		err = m.withAutoTx(ctx, func(txm *Model[T]) error {
+			attached, detached, err = txm.Toggle(ctx, entity, relation, ids, pivotData)
+			return err
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return attached, detached, nil
+	}
+
+	// Get Current IDs
+	currentIDs, err := m.currentPivotIDs(ctx, pivotTable, foreignKey, relatedKey, parentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Split requested IDs into present (detach) and absent (attach)
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		key := anyToKeyString(id)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if curID, exists := currentIDs[key]; exists {
+			detached = append(detached, curID)
+		} else {
+			attached = append(attached, id)
+		}
+	}
+
+	// Execute
+	if len(detached) > 0 {
+		if err := m.Detach(ctx, entity, relation, detached); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(attached) > 0 {
+		if err := m.Attach(ctx, entity, relation, attached, pivotData); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return attached, detached, nil
+}
+
+// currentPivotIDs returns the related IDs currently stored in the pivot
+// table for parentID, keyed by anyToKeyString.
+func (m *Model[T]) currentPivotIDs(ctx context.Context, pivotTable, foreignKey, relatedKey string, parentID any) (map[string]any, error) {
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	sb.WriteString(relatedKey)
+	sb.WriteString(" FROM ")
+	sb.WriteString(pivotTable)
+	sb.WriteString(" WHERE ")
+	sb.WriteString(foreignKey)
+	sb.WriteString(" = ?")
+	query := sb.String()
+	rows, err := m.queryer().QueryContext(ctx, m.rebind(query), parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	currentIDs := make(map[string]any) // string key -> original value
+	for rows.Next() {
+		var id any
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		key := anyToKeyString(id)
+		currentIDs[key] = id
+	}
+	return currentIDs, rows.Err()
+}
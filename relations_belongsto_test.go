@@ -1304,3 +1304,179 @@ func TestBelongsTo_AllNullFKs(t *testing.T) {
 		}
 	}
 }
+
+// ==================== Tag-defined relations ====================
+
+type BookTagged struct {
+	ID       int `zorm:"primaryKey"`
+	Title    string
+	AuthorID int
+	Author   *AuthorInt `zorm:"belongsTo;fk:author_id"`
+}
+
+func (b BookTagged) TableName() string { return "books_int" }
+
+type AuthorTagged struct {
+	ID    int `zorm:"primaryKey"`
+	Name  string
+	Books []*BookTagged `zorm:"hasMany;fk:author_id"`
+}
+
+func (a AuthorTagged) TableName() string { return "authors_int" }
+
+// BookTaggedOverride's tag names a column that does not exist; the relation
+// method must win.
+type BookTaggedOverride struct {
+	ID       int `zorm:"primaryKey"`
+	Title    string
+	AuthorID int
+	Author   *AuthorInt `zorm:"belongsTo;fk:missing_id"`
+}
+
+func (b BookTaggedOverride) TableName() string { return "books_int" }
+
+func (b BookTaggedOverride) AuthorRelation() BelongsTo[AuthorInt] {
+	return BelongsTo[AuthorInt]{ForeignKey: "author_id"}
+}
+
+func TestBelongsTo_TagDefinedRelation(t *testing.T) {
+	db := setupBelongsToIntDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	books, err := New[BookTagged]().SetDB(db).With("Author").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to get books: %v", err)
+	}
+	if len(books) != 4 {
+		t.Fatalf("expected 4 books, got %d", len(books))
+	}
+	for _, b := range books {
+		if b.Author == nil || b.Author.ID != b.AuthorID {
+			t.Errorf("book %d: expected author %d, got %+v", b.ID, b.AuthorID, b.Author)
+		}
+	}
+	if books[2].Author.Name != "Aldous Huxley" {
+		t.Errorf("expected 'Aldous Huxley', got %q", books[2].Author.Name)
+	}
+
+	overridden, err := New[BookTaggedOverride]().SetDB(db).With("Author").Where("id", 1).First(ctx)
+	if err != nil {
+		t.Fatalf("failed to get book: %v", err)
+	}
+	if overridden.Author == nil || overridden.Author.Name != "George Orwell" {
+		t.Errorf("expected the relation method to take precedence, got %+v", overridden.Author)
+	}
+}
+
+// AuthorUntabled has no TableName, so tag relations to it must name the
+// table explicitly.
+type AuthorUntabled struct {
+	ID   int `zorm:"primaryKey"`
+	Name string
+}
+
+type BookTaggedTable struct {
+	ID       int `zorm:"primaryKey"`
+	Title    string
+	AuthorID int
+	Author   *AuthorUntabled `zorm:"belongsTo;fk:author_id;table:authors_int"`
+}
+
+func (b BookTaggedTable) TableName() string { return "books_int" }
+
+func TestBelongsTo_TagDefinedRelationTable(t *testing.T) {
+	db := setupBelongsToIntDB(t)
+	defer db.Close()
+
+	book, err := New[BookTaggedTable]().SetDB(db).With("Author").Where("id", 1).First(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get book: %v", err)
+	}
+	if book.Author == nil || book.Author.Name != "George Orwell" {
+		t.Errorf("expected the author loaded from authors_int, got %+v", book.Author)
+	}
+}
+
+func TestWithCallback_TagDefinedRelation(t *testing.T) {
+	db := setupBelongsToIntDB(t)
+	defer db.Close()
+
+	_, err := New[BookTagged]().SetDB(db).WithCallback("Author", func(q *Model[AuthorInt]) {
+		q.Where("name", "nobody")
+	}).Get(context.Background())
+	if err == nil {
+		t.Error("expected WithCallback on a tag relation to fail instead of dropping the constraint")
+	}
+
+	// A relation method of the same name takes precedence over the tag
+	book, err := New[BookTaggedOverride]().SetDB(db).WithCallback("Author", func(q *Model[AuthorInt]) {
+		q.Where("name", "nobody")
+	}).Where("id", 1).First(context.Background())
+	if err != nil {
+		t.Fatalf("WithCallback on a relation method failed: %v", err)
+	}
+	if book.Author != nil {
+		t.Errorf("expected the callback to filter out the author, got %+v", book.Author)
+	}
+}
+
+func TestHasMany_TagDefinedRelationWithNested(t *testing.T) {
+	db := setupBelongsToIntDB(t)
+	defer db.Close()
+
+	author, err := New[AuthorTagged]().SetDB(db).With("Books.Author").Where("id", 1).First(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get author: %v", err)
+	}
+	if len(author.Books) != 2 {
+		t.Fatalf("expected 2 books, got %d", len(author.Books))
+	}
+	for _, b := range author.Books {
+		if b.Author == nil || b.Author.Name != "George Orwell" {
+			t.Errorf("book %d: expected nested author to load, got %+v", b.ID, b.Author)
+		}
+	}
+}
+
+func TestCreate_GeneratesUUIDPrimaryKey(t *testing.T) {
+	db := setupBelongsToUUIDDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	author := &AuthorUUID{Name: "Ursula K. Le Guin"}
+	if err := New[AuthorUUID]().SetDB(db).Create(ctx, author); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if author.ID == uuid.Nil {
+		t.Fatal("expected a UUID to be generated for the zero primary key")
+	}
+	if author.ID.Version() != 4 {
+		t.Errorf("expected a version 4 UUID, got version %d", author.ID.Version())
+	}
+
+	found, err := New[AuthorUUID]().SetDB(db).Find(ctx, author.ID)
+	if err != nil {
+		t.Fatalf("Find by generated UUID failed: %v", err)
+	}
+	if found.Name != author.Name {
+		t.Errorf("expected persisted author %q, got %q", author.Name, found.Name)
+	}
+
+	// Caller-supplied keys are preserved.
+	preset := uuid.New()
+	if err := New[AuthorUUID]().SetDB(db).Create(ctx, &AuthorUUID{ID: preset, Name: "preset"}); err != nil {
+		t.Fatalf("Create with preset UUID failed: %v", err)
+	}
+	if _, err := New[AuthorUUID]().SetDB(db).Find(ctx, preset); err != nil {
+		t.Errorf("expected preset UUID to be persisted: %v", err)
+	}
+
+	books := []*BookUUID{{Title: "A", AuthorID: author.ID}, {Title: "B", AuthorID: author.ID}}
+	if err := New[BookUUID]().SetDB(db).CreateMany(ctx, books); err != nil {
+		t.Fatalf("CreateMany failed: %v", err)
+	}
+	if books[0].ID == uuid.Nil || books[1].ID == uuid.Nil || books[0].ID == books[1].ID {
+		t.Errorf("expected distinct generated UUIDs, got %v and %v", books[0].ID, books[1].ID)
+	}
+}
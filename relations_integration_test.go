@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -88,6 +90,40 @@ func setupRelDB(t *testing.T) *sql.DB {
 	return db
 }
 
+func TestRelations_FindMany(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	users, err := New[RelUser]().SetDB(db).With("Posts").FindMany(ctx, []any{2, 99, 1})
+	if err != nil {
+		t.Fatalf("FindMany failed: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users for ids [2 99 1], got %d", len(users))
+	}
+	posts := map[int]int{}
+	for _, u := range users {
+		posts[u.ID] = len(u.Posts)
+	}
+	if posts[1] != 2 || posts[2] != 1 {
+		t.Errorf("expected eager-loaded posts {1:2 2:1}, got %v", posts)
+	}
+
+	rec, stop := RecordQueries(ctx)
+	empty, err := New[RelUser]().SetDB(db).With("Posts").FindMany(ctx, nil)
+	stop()
+	if err != nil {
+		t.Fatalf("FindMany with no ids failed: %v", err)
+	}
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("expected an empty, non-nil slice, got %#v", empty)
+	}
+	if err := rec.AssertQueryCount(0); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestRelations_LoadHasMany(t *testing.T) {
 	db := setupRelDB(t)
 	defer db.Close()
@@ -163,6 +199,129 @@ func TestRelations_LoadBelongsTo(t *testing.T) {
 	}
 }
 
+type RelDatedPost struct {
+	ID        int `zorm:"primaryKey"`
+	UserID    int
+	Title     string
+	CreatedAt string
+}
+
+func (RelDatedPost) TableName() string { return "rel_posts" }
+
+type RelUserOrdered struct {
+	ID    int `zorm:"primaryKey"`
+	Name  string
+	Posts []*RelDatedPost
+	Roles []*RelRole
+}
+
+func (RelUserOrdered) TableName() string { return "rel_users" }
+
+func (RelUserOrdered) PostsRelation() HasMany[RelDatedPost] {
+	return HasMany[RelDatedPost]{ForeignKey: "user_id", OrderBy: "created_at DESC"}
+}
+
+func (RelUserOrdered) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{
+		PivotTable: "rel_role_user",
+		ForeignKey: "user_id",
+		RelatedKey: "role_id",
+		OrderBy:    "name ASC",
+	}
+}
+
+type RelUserBadOrder struct {
+	ID    int `zorm:"primaryKey"`
+	Posts []*RelDatedPost
+}
+
+func (RelUserBadOrder) TableName() string { return "rel_users" }
+
+func (RelUserBadOrder) PostsRelation() HasMany[RelDatedPost] {
+	return HasMany[RelDatedPost]{ForeignKey: "user_id", OrderBy: "created_at; DROP TABLE rel_posts"}
+}
+
+func TestRelations_OrderBy(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE rel_users (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_posts (id INTEGER PRIMARY KEY, user_id INTEGER, title TEXT, created_at TEXT);
+		CREATE TABLE rel_roles (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_role_user (user_id INTEGER, role_id INTEGER);
+
+		INSERT INTO rel_users (id, name) VALUES (1, 'Alice'), (2, 'Bob');
+		INSERT INTO rel_posts (id, user_id, title, created_at) VALUES
+		(1, 1, 'oldest', '2024-01-01'),
+		(2, 2, 'bob old', '2024-02-01'),
+		(3, 1, 'newest', '2024-03-01'),
+		(4, 1, 'middle', '2024-02-15'),
+		(5, 2, 'bob new', '2024-04-01');
+		INSERT INTO rel_roles (id, name) VALUES (1, 'Viewer'), (2, 'Admin'), (3, 'Editor');
+		INSERT INTO rel_role_user (user_id, role_id) VALUES (1, 1), (1, 2), (1, 3), (2, 3), (2, 2);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+	ctx := context.Background()
+
+	t.Run("HasMany", func(t *testing.T) {
+		users, err := New[RelUserOrdered]().SetDB(db).With("Posts").OrderBy("id", "ASC").Get(ctx)
+		if err != nil {
+			t.Fatalf("failed to get users: %v", err)
+		}
+		want := [][]string{{"newest", "middle", "oldest"}, {"bob new", "bob old"}}
+		for i, u := range users {
+			var got []string
+			for _, p := range u.Posts {
+				got = append(got, p.Title)
+			}
+			if !slices.Equal(got, want[i]) {
+				t.Errorf("user %d posts = %v, want %v", u.ID, got, want[i])
+			}
+		}
+	})
+
+	t.Run("BelongsToMany", func(t *testing.T) {
+		users, err := New[RelUserOrdered]().SetDB(db).With("Roles").OrderBy("id", "ASC").Get(ctx)
+		if err != nil {
+			t.Fatalf("failed to get users: %v", err)
+		}
+		want := [][]string{{"Admin", "Editor", "Viewer"}, {"Admin", "Editor"}}
+		for i, u := range users {
+			var got []string
+			for _, r := range u.Roles {
+				got = append(got, r.Name)
+			}
+			if !slices.Equal(got, want[i]) {
+				t.Errorf("user %d roles = %v, want %v", u.ID, got, want[i])
+			}
+		}
+	})
+
+	t.Run("CallbackOrderWins", func(t *testing.T) {
+		users, err := New[RelUserOrdered]().SetDB(db).WithCallback("Posts", func(q *Model[RelDatedPost]) {
+			q.OrderBy("created_at", "ASC")
+		}).Where("id", 1).Get(ctx)
+		if err != nil {
+			t.Fatalf("failed to get users: %v", err)
+		}
+		if len(users) != 1 || len(users[0].Posts) != 3 || users[0].Posts[0].Title != "oldest" {
+			t.Errorf("expected callback order to win, got %+v", users)
+		}
+	})
+
+	t.Run("InvalidOrder", func(t *testing.T) {
+		if _, err := New[RelUserBadOrder]().SetDB(db).With("Posts").Get(ctx); err == nil {
+			t.Fatal("expected an error for an invalid relation OrderBy")
+		}
+	})
+}
+
 type RelRole struct {
 	ID    int `zorm:"primaryKey"`
 	Name  string
@@ -260,6 +419,32 @@ func TestRelations_BelongsToMany(t *testing.T) {
 	}
 }
 
+func TestRelations_WhereHas_BelongsToMany(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	users, err := New[RelUserExtended]().SetDB(db).WhereHas("Roles", func(q *Model[RelRole]) {
+		q.Where("name", "Editor")
+	}).Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereHas failed: %v", err)
+	}
+	if len(users) != 1 || users[0].Name != "Alice" {
+		t.Errorf("expected Alice to have the Editor role, got %+v", users)
+	}
+
+	users, err = New[RelUserExtended]().SetDB(db).WhereHas("Roles", func(q *Model[RelRole]) {
+		q.Where("name", "Viewer")
+	}).Get(ctx)
+	if err != nil {
+		t.Fatalf("WhereHas failed: %v", err)
+	}
+	if len(users) != 0 {
+		t.Errorf("expected no users with the Viewer role, got %+v", users)
+	}
+}
+
 func TestRelations_MorphMany(t *testing.T) {
 	db := setupRelDBExtended(t)
 	defer db.Close()
@@ -283,6 +468,85 @@ func TestRelations_MorphMany(t *testing.T) {
 	}
 }
 
+// RelUploadedImage is a polymorphic image that also belongs to the user who
+// uploaded it.
+type RelUploadedImage struct {
+	ID            int `zorm:"primaryKey"`
+	URL           string
+	ImageableID   int
+	ImageableType string
+	UploaderID    int
+	Uploader      *RelUser
+}
+
+func (i RelUploadedImage) TableName() string { return "rel_uploaded_images" }
+
+func (i RelUploadedImage) UploaderRelation() BelongsTo[RelUser] {
+	return BelongsTo[RelUser]{ForeignKey: "uploader_id"}
+}
+
+type RelGallery struct {
+	ID     int `zorm:"primaryKey"`
+	Name   string
+	Images []*RelUploadedImage // MorphMany
+}
+
+func (g RelGallery) TableName() string { return "rel_galleries" }
+
+func (g RelGallery) ImagesRelation() MorphMany[RelUploadedImage] {
+	return MorphMany[RelUploadedImage]{
+		Type: "imageable_type",
+		ID:   "imageable_id",
+	}
+}
+
+func TestRelations_MorphMany_NestedBelongsTo(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE rel_users (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_galleries (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_uploaded_images (id INTEGER PRIMARY KEY, url TEXT, imageable_id INTEGER, imageable_type TEXT, uploader_id INTEGER);
+
+		INSERT INTO rel_users (id, name) VALUES (1, 'Alice'), (2, 'Bob');
+		INSERT INTO rel_galleries (id, name) VALUES (1, 'Holidays');
+		INSERT INTO rel_uploaded_images (id, url, imageable_id, imageable_type, uploader_id) VALUES
+		(1, 'beach.jpg', 1, 'RelGallery', 1),
+		(2, 'hills.jpg', 1, 'RelGallery', 2);
+	`)
+	if err != nil {
+		t.Fatalf("failed to setup DB: %v", err)
+	}
+
+	galleries, err := New[RelGallery]().SetDB(db).With("Images.Uploader").Get(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get galleries: %v", err)
+	}
+	if len(galleries) != 1 {
+		t.Fatalf("expected 1 gallery, got %d", len(galleries))
+	}
+
+	images := galleries[0].Images
+	if len(images) != 2 {
+		t.Fatalf("expected 2 images, got %d", len(images))
+	}
+
+	want := map[int]string{1: "Alice", 2: "Bob"}
+	for _, img := range images {
+		if img.Uploader == nil {
+			t.Errorf("image %d: expected Uploader to be loaded, got nil", img.ID)
+			continue
+		}
+		if img.Uploader.Name != want[img.ID] {
+			t.Errorf("image %d: expected uploader %q, got %q", img.ID, want[img.ID], img.Uploader.Name)
+		}
+	}
+}
+
 type RelComment struct {
 	ID              int `zorm:"primaryKey"`
 	Content         string
@@ -365,6 +629,137 @@ func TestRelations_MorphTo(t *testing.T) {
 	}
 }
 
+// RelPhoto configures its MorphTo relation with column names rather than
+// struct field names.
+type RelPhoto struct {
+	ID            int `zorm:"primaryKey"`
+	URL           string
+	ImageableID   int
+	ImageableType string
+	Imageable     any
+}
+
+func (RelPhoto) TableName() string { return "rel_photos" }
+
+func (RelPhoto) ImageableRelation() MorphTo[any] {
+	return MorphTo[any]{
+		Type: "imageable_type",
+		ID:   "imageable_id",
+		TypeMap: map[string]any{
+			"RelUserExtended": RelUserExtended{},
+			"RelPost":         RelPost{},
+		},
+	}
+}
+
+func TestRelations_MorphTo_ColumnNameConfig(t *testing.T) {
+	db := setupRelDBMorphTo(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE rel_photos (id INTEGER PRIMARY KEY, url TEXT, imageable_id INTEGER, imageable_type TEXT);
+		INSERT INTO rel_photos (id, url, imageable_id, imageable_type) VALUES
+		(1, 'alice.png', 1, 'RelUserExtended'),
+		(2, 'post.png', 1, 'RelPost');
+	`)
+	if err != nil {
+		t.Fatalf("failed to seed photos: %v", err)
+	}
+
+	photos, err := New[RelPhoto]().SetDB(db).With("Imageable").OrderBy("id", "ASC").Get(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get photos: %v", err)
+	}
+	if len(photos) != 2 {
+		t.Fatalf("expected 2 photos, got %d", len(photos))
+	}
+	if user, ok := photos[0].Imageable.(*RelUserExtended); !ok || user.Name != "Alice" {
+		t.Errorf("photo 1: expected Alice, got %#v", photos[0].Imageable)
+	}
+	if post, ok := photos[1].Imageable.(*RelPost); !ok || post.Title != "Post 1" {
+		t.Errorf("photo 2: expected Post 1, got %#v", photos[1].Imageable)
+	}
+}
+
+func TestRelations_WhereHasMorph(t *testing.T) {
+	db := setupRelDBMorphTo(t)
+	defer db.Close()
+
+	// Comments 3 and 4 point at owners that do not exist.
+	_, err := db.Exec(`
+		INSERT INTO rel_posts (id, user_id, title) VALUES (2, 1, 'Post 2');
+		INSERT INTO rel_comments (id, content, commentable_id, commentable_type) VALUES
+		(3, 'Ghost user', 9, 'RelUserExtended'),
+		(4, 'Ghost post', 9, 'RelPost'),
+		(5, 'Post 2 is short', 2, 'RelPost');
+	`)
+	if err != nil {
+		t.Fatalf("failed to seed comments: %v", err)
+	}
+
+	ctx := context.Background()
+	commentIDs := func(q *Model[RelComment]) []int {
+		t.Helper()
+		comments, err := q.OrderBy("id", "ASC").Get(ctx)
+		if err != nil {
+			t.Fatalf("WhereHasMorph failed: %v", err)
+		}
+		ids := make([]int, len(comments))
+		for i, c := range comments {
+			ids[i] = c.ID
+		}
+		return ids
+	}
+
+	t.Run("both types", func(t *testing.T) {
+		ids := commentIDs(New[RelComment]().SetDB(db).WhereHasMorph("Commentable", []any{RelPost{}, RelUserExtended{}}))
+		if want := []int{1, 2, 5}; !slices.Equal(ids, want) {
+			t.Errorf("expected comments %v, got %v", want, ids)
+		}
+	})
+
+	t.Run("one type", func(t *testing.T) {
+		ids := commentIDs(New[RelComment]().SetDB(db).WhereHasMorph("Commentable", []any{RelPost{}}))
+		if want := []int{2, 5}; !slices.Equal(ids, want) {
+			t.Errorf("expected comments %v, got %v", want, ids)
+		}
+	})
+
+	t.Run("constraint on one type", func(t *testing.T) {
+		ids := commentIDs(New[RelComment]().SetDB(db).WhereHasMorph("Commentable", []any{RelPost{}, RelUserExtended{}},
+			func(q *Model[RelPost]) { q.Where("title", "Post 2") }))
+		if want := []int{1, 5}; !slices.Equal(ids, want) {
+			t.Errorf("expected comments %v, got %v", want, ids)
+		}
+	})
+
+	t.Run("print", func(t *testing.T) {
+		sql, args := New[RelComment]().WhereHasMorph("Commentable", []any{RelPost{}},
+			func(q *Model[RelPost]) { q.Where("title", "Post 2") }).Print()
+		want := "SELECT * FROM rel_comments WHERE 1=1  AND ((rel_comments.commentable_type = $1 AND EXISTS (SELECT 1 FROM rel_posts WHERE rel_posts.id = rel_comments.commentable_id AND title = $2)))"
+		if sql != want {
+			t.Errorf("unexpected SQL:\n got %s\nwant %s", sql, want)
+		}
+		if len(args) != 2 || args[0] != "RelPost" || args[1] != "Post 2" {
+			t.Errorf("unexpected args: %v", args)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := map[string]*Model[RelComment]{
+			"not morphTo":        New[RelComment]().SetDB(db).WhereHasMorph("Missing", []any{RelPost{}}),
+			"unknown type":       New[RelComment]().SetDB(db).WhereHasMorph("Commentable", []any{RelRole{}}),
+			"bad callback":       New[RelComment]().SetDB(db).WhereHasMorph("Commentable", []any{RelPost{}}, func() {}),
+			"unmatched callback": New[RelComment]().SetDB(db).WhereHasMorph("Commentable", []any{RelPost{}}, func(q *Model[RelUserExtended]) {}),
+		}
+		for name, q := range cases {
+			if _, err := q.Get(ctx); err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+		}
+	})
+}
+
 func TestRelations_Nested(t *testing.T) {
 	db := setupRelDBMorphTo(t)
 	defer db.Close()
@@ -444,8 +839,8 @@ func TestRelations_NestedWithCols_MissingFK(t *testing.T) {
 
 	ctx := context.Background()
 	// FK column (commentable_id) is omitted from column selection.
-	// The query succeeds but the FK field is zero-valued, so children
-	// cannot be mapped back to parents — resulting in empty slices.
+	// The loader injects it automatically so children still map back
+	// to their parents.
 	users, err := New[RelUser]().With("Posts.Comments:id,content").Get(ctx)
 	if err != nil {
 		t.Fatalf("failed to get users: %v", err)
@@ -458,8 +853,8 @@ func TestRelations_NestedWithCols_MissingFK(t *testing.T) {
 			}
 			for _, p := range u.Posts {
 				if p.Title == "Post 1" {
-					if len(p.Comments) != 0 {
-						t.Errorf("expected 0 comments when FK not in column selection, got %d", len(p.Comments))
+					if len(p.Comments) != 2 {
+						t.Errorf("expected 2 comments when FK not in column selection, got %d", len(p.Comments))
 					}
 				}
 			}
@@ -490,6 +885,87 @@ func TestRelations_LoadSlice(t *testing.T) {
 	}
 }
 
+func TestRelations_LoadMissing(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	user, err := New[RelUserExtended]().SetDB(db).With("Posts").Find(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	if len(user.Posts) != 1 {
+		t.Fatalf("expected 1 eager-loaded post, got %d", len(user.Posts))
+	}
+
+	rec, stop := RecordQueries(ctx)
+	err = New[RelUserExtended]().SetDB(db).LoadMissing(ctx, user, "Posts", "Roles")
+	stop()
+	if err != nil {
+		t.Fatalf("LoadMissing failed: %v", err)
+	}
+
+	// Only Roles is missing: one pivot query and one for the roles themselves
+	if err := rec.AssertQueryCount(2); err != nil {
+		t.Error(err)
+	}
+	if err := rec.AssertContains("FROM rel_posts"); err == nil {
+		t.Error("expected the already-loaded Posts not to be queried again")
+	}
+	if len(user.Roles) != 2 {
+		t.Errorf("expected 2 roles, got %d", len(user.Roles))
+	}
+
+	// Everything is loaded now, so nothing is queried
+	rec, stop = RecordQueries(ctx)
+	err = New[RelUserExtended]().SetDB(db).LoadMissing(ctx, user, "Posts", "Roles")
+	stop()
+	if err != nil {
+		t.Fatalf("LoadMissing failed: %v", err)
+	}
+	if err := rec.AssertQueryCount(0); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRelations_PreloadDeduplicates(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	first, _ := New[RelUser]().Get(ctx)
+	second, _ := New[RelUser]().Where("name", "Alice").Get(ctx)
+	if len(second) != 1 {
+		t.Fatalf("expected 1 user named Alice, got %d", len(second))
+	}
+	users := append(append(first, second...), second[0], nil)
+
+	if err := New[RelUser]().Preload(ctx, users, "Posts"); err != nil {
+		t.Fatalf("Preload failed: %v", err)
+	}
+	for _, u := range users {
+		if u == nil {
+			continue
+		}
+		if u.Name == "Alice" && len(u.Posts) != 2 {
+			t.Errorf("expected 2 posts for every Alice entry, got %d", len(u.Posts))
+		}
+		for _, p := range u.Posts {
+			if p.UserID != u.ID {
+				t.Errorf("post %d attached to the wrong user %d", p.ID, u.ID)
+			}
+		}
+	}
+
+	if err := New[RelUser]().Preload(ctx, nil, "Posts"); err != nil {
+		t.Errorf("expected Preload on no entities to succeed, got %v", err)
+	}
+}
+
 // ==================== HasOne Tests ====================
 
 type RelProfile struct {
@@ -829,22 +1305,15 @@ func TestHasOne_EagerLoading(t *testing.T) {
 
 	ctx := context.Background()
 
-	// HasOne eager loading via loadHasMany panics because reflect.MakeSlice
-	// is called on a pointer type (*RelProfile) instead of a slice type.
-	// MorphOne handles this correctly (lines 1149-1158) but HasOne reuses
-	// loadHasMany without single-value logic.
-	panicked := true
-	func() {
-		defer func() {
-			if r := recover(); r == nil {
-				panicked = false
-			}
-		}()
-		_, _ = New[RelUserWithProfile]().With("Profile").Get(ctx)
-	}()
-
-	if !panicked {
-		t.Error("expected HasOne eager loading to panic due to reflect.MakeSlice on pointer type, but it did not panic")
+	users, err := New[RelUserWithProfile]().With("Profile").Get(ctx)
+	if err != nil {
+		t.Fatalf("HasOne eager loading failed: %v", err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(users))
+	}
+	if users[0].Profile == nil || users[0].Profile.Bio != "Alice bio" {
+		t.Errorf("expected Profile to be loaded, got %+v", users[0].Profile)
 	}
 }
 
@@ -888,7 +1357,7 @@ func TestWith_ColsOnRootRelation(t *testing.T) {
 	})
 
 	t.Run("MissingFK", func(t *testing.T) {
-		users, err := New[RelUser]().With("Posts:id,title").Get(ctx)
+		users, err := New[RelUser]().With("Posts:title").Get(ctx)
 		if err != nil {
 			t.Fatalf("failed to get users: %v", err)
 		}
@@ -904,10 +1373,15 @@ func TestWith_ColsOnRootRelation(t *testing.T) {
 			t.Fatal("Alice not found")
 		}
 
-		// FK user_id is omitted from column selection, so posts can't be
-		// mapped back to parents — resulting in 0 posts.
-		if len(alice.Posts) != 0 {
-			t.Errorf("expected 0 posts when FK omitted from cols, got %d", len(alice.Posts))
+		// FK user_id is omitted from column selection; the loader adds it
+		// back so posts still map to their parents.
+		if len(alice.Posts) != 2 {
+			t.Errorf("expected 2 posts when FK omitted from cols, got %d", len(alice.Posts))
+		}
+		for _, p := range alice.Posts {
+			if p.Title == "" {
+				t.Error("expected Title to be populated")
+			}
 		}
 	})
 }
@@ -1115,6 +1589,52 @@ func TestNested_ThreeLevels(t *testing.T) {
 	}
 }
 
+// TestNested_ThreeLevelsMixedBelongsTo verifies three-level chains that
+// alternate HasMany and BelongsTo populate the deepest relation.
+func TestNested_ThreeLevelsMixedBelongsTo(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+
+	// HasMany -> BelongsTo -> HasMany
+	alice, err := New[RelUser]().Where("name", "Alice").With("Posts.User.Posts").First(ctx)
+	if err != nil {
+		t.Fatalf("failed to get Alice: %v", err)
+	}
+	if len(alice.Posts) != 2 {
+		t.Fatalf("expected 2 posts, got %d", len(alice.Posts))
+	}
+	for _, p := range alice.Posts {
+		if p.User == nil || p.User.ID != alice.ID {
+			t.Fatalf("post %d: expected User to be Alice, got %+v", p.ID, p.User)
+		}
+		if len(p.User.Posts) != 2 {
+			t.Errorf("post %d: expected the deepest Posts to hold 2 posts, got %d", p.ID, len(p.User.Posts))
+		}
+	}
+
+	// BelongsTo -> HasMany -> BelongsTo
+	posts, err := New[RelPost]().With("User.Posts.User").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to get posts: %v", err)
+	}
+	for _, p := range posts {
+		if p.User == nil || len(p.User.Posts) == 0 {
+			t.Fatalf("post %d: expected User and its Posts to be loaded, got %+v", p.ID, p.User)
+		}
+		for _, sibling := range p.User.Posts {
+			if sibling.User == nil || sibling.User.ID != p.UserID {
+				t.Errorf("post %d: expected the deepest User to be %d, got %+v", sibling.ID, p.UserID, sibling.User)
+			}
+		}
+	}
+}
+
 // ==================== Test 7: Load Single Entity HasMany ====================
 
 func TestLoad_SingleEntity_HasMany(t *testing.T) {
@@ -1437,6 +1957,67 @@ func TestWithCallback_MultipleRelations(t *testing.T) {
 	}
 }
 
+// TestWithCallback_OrWhereStaysScoped verifies an OrWhere inside the callback
+// is grouped with the other constraints and cannot pull in rows belonging to
+// other parents, while an unconstrained load still returns everything.
+func TestWithCallback_OrWhereStaysScoped(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+
+	alice, err := New[RelUser]().Where("name", "Alice").WithCallback("Posts", func(q *Model[RelPost]) {
+		q.Where("title", "Post 1").OrWhere("title", "Post 3")
+	}).First(ctx)
+	if err != nil {
+		t.Fatalf("failed to get Alice: %v", err)
+	}
+	if len(alice.Posts) != 1 || alice.Posts[0].Title != "Post 1" {
+		t.Errorf("expected only Alice's 'Post 1', got %+v", alice.Posts)
+	}
+
+	alice, err = New[RelUser]().Where("name", "Alice").With("Posts").First(ctx)
+	if err != nil {
+		t.Fatalf("failed to get Alice: %v", err)
+	}
+	if len(alice.Posts) != 2 {
+		t.Errorf("expected 2 posts without a callback, got %d", len(alice.Posts))
+	}
+}
+
+// TestWithCallback_BelongsTo verifies callback constraints filter the owners
+// loaded for a BelongsTo relation.
+func TestWithCallback_BelongsTo(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	posts, err := New[RelPost]().WithCallback("User", func(q *Model[RelUser]) {
+		q.Where("name", "Alice")
+	}).OrderBy("id", "ASC").Get(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get posts: %v", err)
+	}
+	if len(posts) != 3 {
+		t.Fatalf("expected 3 posts, got %d", len(posts))
+	}
+	for _, p := range posts[:2] {
+		if p.User == nil || p.User.Name != "Alice" {
+			t.Errorf("expected post %d to load Alice, got %+v", p.ID, p.User)
+		}
+	}
+	if posts[2].User != nil {
+		t.Errorf("expected Bob to be filtered out for post 3, got %+v", posts[2].User)
+	}
+}
+
 // =============================================================================
 // ISSUE #2: NESTED DYNAMIC RELATIONS (ALL TYPES) TESTS
 // =============================================================================
@@ -1656,3 +2237,680 @@ func TestBelongsToMany_UserWithNoRoles(t *testing.T) {
 		t.Errorf("expected Bob to have 0 roles, got %d", len(bob.Roles))
 	}
 }
+
+type RelUserExists struct {
+	ID       int `zorm:"primaryKey"`
+	Name     string
+	HasPosts bool `zorm:"column:has_posts"`
+	HasRoles bool `zorm:"column:has_roles"`
+}
+
+func (u RelUserExists) TableName() string { return "rel_users" }
+
+func (u RelUserExists) PostsRelation() HasMany[RelPost] {
+	return HasMany[RelPost]{ForeignKey: "user_id"}
+}
+
+func (u RelUserExists) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{
+		PivotTable: "rel_role_user",
+		ForeignKey: "user_id",
+		RelatedKey: "role_id",
+	}
+}
+
+func TestRelations_WithExists(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`INSERT INTO rel_users (id, name) VALUES (2, 'Bob')`); err != nil {
+		t.Fatal(err)
+	}
+
+	users, err := New[RelUserExists]().SetDB(db).
+		WithExists("Posts", "has_posts").
+		WithExists("Roles", "has_roles").
+		OrderBy("id", "ASC").
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("WithExists failed: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+	if !users[0].HasPosts || !users[0].HasRoles {
+		t.Errorf("expected Alice to have posts and roles, got %+v", users[0])
+	}
+	if users[1].HasPosts || users[1].HasRoles {
+		t.Errorf("expected Bob to have neither posts nor roles, got %+v", users[1])
+	}
+}
+
+func TestRelations_WithExists_BelongsTo(t *testing.T) {
+	query, _ := New[RelPost]().WithExists("User", "has_user").Print()
+	want := "EXISTS (SELECT 1 FROM rel_users WHERE rel_users.id = rel_posts.user_id) AS has_user"
+	if !strings.Contains(query, want) {
+		t.Errorf("expected %q in query, got %q", want, query)
+	}
+}
+
+func TestRelations_WithExists_Invalid(t *testing.T) {
+	if m := New[RelUserExists]().WithExists("Missing", "x"); !errors.Is(m.buildErr, ErrRelationNotFound) {
+		t.Errorf("expected ErrRelationNotFound, got %v", m.buildErr)
+	}
+	if m := New[RelUserExists]().WithExists("Posts", "x; DROP"); m.buildErr == nil {
+		t.Error("expected buildErr for invalid alias")
+	}
+}
+
+func TestRelations_FindWith_BelongsTo(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	post, err := New[RelPost]().SetDB(db).FindWith(ctx, 3, "User")
+	if err != nil {
+		t.Fatalf("FindWith failed: %v", err)
+	}
+	if post.Title != "Post 3" {
+		t.Errorf("expected Post 3, got %q", post.Title)
+	}
+	if post.User == nil || post.User.Name != "Bob" {
+		t.Errorf("expected User Bob to be loaded, got %+v", post.User)
+	}
+
+	if _, err := New[RelPost]().SetDB(db).FindWith(ctx, 99, "User"); !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+}
+
+func TestRelations_ContextCancelledBeforeEagerLoad(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	users, err := New[RelUser]().Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to get users: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+
+	// Cancel between the main query and the relation queries.
+	cancel()
+	err = New[RelUser]().With("Posts").loadRelations(ctx, users)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	for _, u := range users {
+		if u.Posts != nil {
+			t.Errorf("expected no posts loaded for user %d, got %d", u.ID, len(u.Posts))
+		}
+	}
+
+	// Get with an already-cancelled context must also surface the context error.
+	if _, err := New[RelUser]().With("Posts").Get(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled from Get, got %v", err)
+	}
+}
+
+func TestRelations_LoadSliceReport(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	if _, err := db.Exec(`INSERT INTO rel_users (id, name) VALUES (3, 'Carol'), (4, 'Dave')`); err != nil {
+		t.Fatalf("failed to insert users: %v", err)
+	}
+
+	ctx := context.Background()
+	users, err := New[RelUser]().OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to get users: %v", err)
+	}
+
+	empty, err := New[RelUser]().LoadSliceReport(ctx, users, "Posts")
+	if err != nil {
+		t.Fatalf("LoadSliceReport failed: %v", err)
+	}
+
+	got := empty["Posts"]
+	if len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("expected indices [2 3] (Carol, Dave) without posts, got %v", got)
+	}
+	if len(users[0].Posts) != 2 || len(users[1].Posts) != 1 {
+		t.Errorf("expected posts to be loaded for Alice and Bob, got %d and %d", len(users[0].Posts), len(users[1].Posts))
+	}
+
+	// Every parent has posts: the relation is absent from the report.
+	empty, err = New[RelUser]().LoadSliceReport(ctx, users[:2], "Posts")
+	if err != nil {
+		t.Fatalf("LoadSliceReport failed: %v", err)
+	}
+	if _, ok := empty["Posts"]; ok {
+		t.Errorf("expected no empty parents, got %v", empty)
+	}
+}
+
+func TestRelations_LoadSliceReport_BelongsToManyNoPivotRows(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	if _, err := db.Exec(`DELETE FROM rel_role_user; INSERT INTO rel_users (id, name) VALUES (2, 'Bob')`); err != nil {
+		t.Fatalf("failed to reset pivot rows: %v", err)
+	}
+	users, err := New[RelUserExtended]().SetDB(db).OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to get users: %v", err)
+	}
+
+	empty, err := New[RelUserExtended]().SetDB(db).LoadSliceReport(ctx, users, "Roles")
+	if err != nil {
+		t.Fatalf("LoadSliceReport failed: %v", err)
+	}
+	if got := empty["Roles"]; len(got) != 2 || got[0] != 0 || got[1] != 1 {
+		t.Errorf("expected every parent reported without roles, got %v", got)
+	}
+}
+
+func TestRelations_ChunkEagerLoadsPerChunk(t *testing.T) {
+	db := setupRelDB(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	if _, err := db.Exec(`INSERT INTO rel_users (id, name) VALUES (3, 'Carol')`); err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	postCounts := map[int]int{}
+	chunks := 0
+	err := New[RelUser]().With("Posts").OrderBy("id", "ASC").Chunk(context.Background(), 2, func(users []*RelUser) error {
+		chunks++
+		for _, u := range users {
+			postCounts[u.ID] = len(u.Posts)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Chunk failed: %v", err)
+	}
+
+	if chunks != 2 {
+		t.Errorf("expected 2 chunks, got %d", chunks)
+	}
+	if postCounts[1] != 2 || postCounts[2] != 1 || postCounts[3] != 0 {
+		t.Errorf("expected posts loaded inside the callback (1:2, 2:1, 3:0), got %v", postCounts)
+	}
+}
+
+type RelUserDefaults struct {
+	ID    int `zorm:"primaryKey"`
+	Name  string
+	Posts []*RelPost
+	Roles []*RelRole
+}
+
+func (u RelUserDefaults) TableName() string { return "rel_users" }
+
+func (u RelUserDefaults) DefaultRelations() []string { return []string{"Posts", "Roles"} }
+
+func (u RelUserDefaults) PostsRelation() HasMany[RelPost] {
+	return HasMany[RelPost]{ForeignKey: "user_id"}
+}
+
+func (u RelUserDefaults) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{
+		PivotTable: "rel_role_user",
+		ForeignKey: "user_id",
+		RelatedKey: "role_id",
+	}
+}
+
+func TestRelations_DefaultRelationsWithoutAndWithOnly(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		query     *Model[RelUserDefaults]
+		wantPosts int
+		wantRoles int
+	}{
+		{"defaults", New[RelUserDefaults](), 1, 2},
+		{"Without removes a default", New[RelUserDefaults]().Without("Roles"), 1, 0},
+		{"WithOnly replaces the set", New[RelUserDefaults]().WithOnly("Roles"), 0, 2},
+		{"WithOnly with no relations", New[RelUserDefaults]().WithOnly(), 0, 0},
+		{"Acquire applies defaults", Acquire[RelUserDefaults]().Without("Posts"), 0, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := tt.query.SetDB(db).First(ctx)
+			if err != nil {
+				t.Fatalf("First failed: %v", err)
+			}
+			if len(user.Posts) != tt.wantPosts || len(user.Roles) != tt.wantRoles {
+				t.Errorf("expected %d posts and %d roles, got %d and %d", tt.wantPosts, tt.wantRoles, len(user.Posts), len(user.Roles))
+			}
+		})
+	}
+}
+
+type RelUserCounts struct {
+	ID         int `zorm:"primaryKey"`
+	Name       string
+	PostsCount int `zorm:"column:posts_count"`
+	RolesCount int `zorm:"column:roles_count"`
+}
+
+func (u RelUserCounts) TableName() string { return "rel_users" }
+
+func (u RelUserCounts) PostsRelation() HasMany[RelPost] {
+	return HasMany[RelPost]{ForeignKey: "user_id"}
+}
+
+func (u RelUserCounts) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{
+		PivotTable: "rel_role_user",
+		ForeignKey: "user_id",
+		RelatedKey: "role_id",
+	}
+}
+
+func TestRelations_WithCountJoin(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`
+		INSERT INTO rel_users (id, name) VALUES (2, 'Bob'), (3, 'Carol');
+		INSERT INTO rel_posts (id, user_id, title) VALUES (2, 1, 'Post 2'), (3, 3, 'Post 3');
+		INSERT INTO rel_role_user (user_id, role_id) VALUES (3, 2);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	users, err := New[RelUserCounts]().SetDB(db).
+		WithCountJoin("Posts", "posts_count").
+		WithCountJoin("Roles", "roles_count").
+		OrderBy("rel_users.id", "ASC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("WithCountJoin failed: %v", err)
+	}
+	if len(users) != 3 {
+		t.Fatalf("expected 3 users, got %d", len(users))
+	}
+
+	// Each count must match the separate per-parent count query.
+	for _, u := range users {
+		posts, err := New[RelPost]().SetDB(db).Where("user_id", u.ID).Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var roles int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM rel_role_user WHERE user_id = ?`, u.ID).Scan(&roles); err != nil {
+			t.Fatal(err)
+		}
+		if int64(u.PostsCount) != posts || u.RolesCount != roles {
+			t.Errorf("user %d: expected %d posts and %d roles, got %+v", u.ID, posts, roles, u)
+		}
+	}
+	if users[1].PostsCount != 0 || users[1].RolesCount != 0 {
+		t.Errorf("expected Bob to have zero counts, got %+v", users[1])
+	}
+}
+
+func TestRelations_WithCountJoin_Query(t *testing.T) {
+	query, _ := New[RelUserCounts]().Select("id", "name").WithCountJoin("Posts", "posts_count").Print()
+	want := "SELECT rel_users.id, rel_users.name, COUNT(DISTINCT rel_posts.id) AS posts_count FROM rel_users " +
+		"LEFT JOIN rel_posts ON rel_posts.user_id = rel_users.id GROUP BY rel_users.id, rel_users.name"
+	if query != want {
+		t.Errorf("expected %q, got %q", want, query)
+	}
+
+	if m := New[RelUserCounts]().WithCountJoin("Missing", "x"); !errors.Is(m.buildErr, ErrRelationNotFound) {
+		t.Errorf("expected ErrRelationNotFound, got %v", m.buildErr)
+	}
+	if m := New[RelUserCounts]().WithCountJoin("Posts", "x; DROP"); m.buildErr == nil {
+		t.Error("expected buildErr for invalid alias")
+	}
+}
+
+type RelUserWithCount struct {
+	ID         int `zorm:"primaryKey"`
+	Name       string
+	Attributes map[string]any `zorm:"-"`
+}
+
+func (u RelUserWithCount) TableName() string { return "rel_users" }
+
+func (u RelUserWithCount) PostsRelation() HasMany[RelPost] {
+	return HasMany[RelPost]{ForeignKey: "user_id"}
+}
+
+func (u RelUserWithCount) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{
+		PivotTable: "rel_role_user",
+		ForeignKey: "user_id",
+		RelatedKey: "role_id",
+	}
+}
+
+func TestRelations_WithCount(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`
+		INSERT INTO rel_users (id, name) VALUES (2, 'Bob'), (3, 'Carol');
+		INSERT INTO rel_posts (id, user_id, title) VALUES (2, 1, 'Post 2'), (3, 3, 'Post 2'), (4, 3, 'Post 4');
+		INSERT INTO rel_role_user (user_id, role_id) VALUES (3, 2);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	users, err := New[RelUserWithCount]().SetDB(db).
+		WithCount("Posts", "Roles:role_id=2").
+		OrderBy("id", "ASC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("WithCount failed: %v", err)
+	}
+	if len(users) != 3 {
+		t.Fatalf("expected 3 users, got %d", len(users))
+	}
+	want := [][2]int64{{2, 1}, {0, 0}, {2, 1}} // posts, roles with role_id 2
+	for i, u := range users {
+		if u.Attributes["posts_count"] != want[i][0] || u.Attributes["roles_count"] != want[i][1] {
+			t.Errorf("%s: expected posts_count=%d roles_count=%d, got %v", u.Name, want[i][0], want[i][1], u.Attributes)
+		}
+	}
+
+	users, err = New[RelUserWithCount]().SetDB(db).WithCount("Posts:title=Post 2").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("WithCount with filter failed: %v", err)
+	}
+	for i, n := range []int64{1, 0, 1} {
+		if users[i].Attributes["posts_count"] != n {
+			t.Errorf("%s: expected %d posts titled 'Post 2', got %v", users[i].Name, n, users[i].Attributes["posts_count"])
+		}
+	}
+
+	if _, err := New[RelUserWithCount]().SetDB(db).WithCount("Posts:title").Get(ctx); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("expected ErrInvalidConfig for a malformed filter, got %v", err)
+	}
+}
+
+type RelOrder struct {
+	ID         int `zorm:"primaryKey"`
+	CustomerID int
+	Total      float64
+}
+
+func (RelOrder) TableName() string { return "rel_orders" }
+
+type RelCustomer struct {
+	ID         int `zorm:"primaryKey"`
+	Name       string
+	Attributes map[string]any
+}
+
+func (RelCustomer) TableName() string { return "rel_customers" }
+
+func (RelCustomer) OrdersRelation() HasMany[RelOrder] {
+	return HasMany[RelOrder]{ForeignKey: "customer_id"}
+}
+
+func (RelCustomer) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{PivotTable: "rel_role_customer"}
+}
+
+func TestRelations_WithSumAndAvg(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`
+		CREATE TABLE rel_customers (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_orders (id INTEGER PRIMARY KEY, customer_id INTEGER, total REAL);
+		INSERT INTO rel_customers (id, name) VALUES (1, 'Ann'), (2, 'Ben'), (3, 'Cid');
+		INSERT INTO rel_orders (id, customer_id, total) VALUES (1, 1, 10), (2, 1, 25.5), (3, 3, 7);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	customers, err := New[RelCustomer]().SetDB(db).
+		WithSum("Orders", "total").
+		WithAvg("Orders", "total").
+		OrderBy("id", "ASC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("WithSum/WithAvg failed: %v", err)
+	}
+	want := [][2]float64{{35.5, 17.75}, {0, 0}, {7, 7}} // sum, avg; Ben has no orders
+	for i, c := range customers {
+		if c.Attributes["orders_sum_total"] != want[i][0] || c.Attributes["orders_avg_total"] != want[i][1] {
+			t.Errorf("%s: expected sum=%v avg=%v, got %v", c.Name, want[i][0], want[i][1], c.Attributes)
+		}
+	}
+
+	customer, err := New[RelCustomer]().SetDB(db).WithSum("Orders", "total").Find(ctx, 1)
+	if err != nil {
+		t.Fatalf("Find with WithSum failed: %v", err)
+	}
+	if customer.Attributes["orders_sum_total"] != 35.5 {
+		t.Errorf("expected orders_sum_total=35.5, got %v", customer.Attributes)
+	}
+
+	invalid := []struct {
+		name  string
+		query *Model[RelCustomer]
+	}{
+		{"invalid column", New[RelCustomer]().WithSum("Orders", "total; DROP TABLE rel_orders")},
+		{"unknown relation", New[RelCustomer]().WithAvg("Invoices", "total")},
+		{"unsupported relation", New[RelCustomer]().WithSum("Roles", "id")},
+	}
+	for _, tt := range invalid {
+		if _, err := tt.query.SetDB(db).Get(ctx); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestRelations_CursorLoadsRelations(t *testing.T) {
+	// Relation queries run while the cursor's rows are open, so they need a
+	// second connection to the same in-memory database.
+	db, err := sql.Open("sqlite3", "file:cursor_relations?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`
+		CREATE TABLE rel_users (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_posts (id INTEGER PRIMARY KEY, user_id INTEGER, title TEXT);
+		INSERT INTO rel_users (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Carol');
+		INSERT INTO rel_posts (id, user_id, title) VALUES (1, 1, 'a1'), (2, 1, 'a2'), (3, 2, 'b1'), (4, 3, 'c1');
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	wantPosts := map[int]int{1: 2, 2: 1, 3: 1}
+
+	t.Run("per row", func(t *testing.T) {
+		rec, stop := RecordQueries(ctx)
+		cursor, err := New[RelUser]().SetDB(db).With("Posts").OrderBy("id", "ASC").Cursor(ctx)
+		if err != nil {
+			t.Fatalf("Cursor failed: %v", err)
+		}
+		var users []*RelUser
+		for cursor.Next() {
+			u, err := cursor.Scan(ctx)
+			if err != nil {
+				t.Fatalf("Scan failed: %v", err)
+			}
+			users = append(users, u)
+		}
+		cursor.Close()
+		stop()
+
+		// The cursor query plus one Posts query per row
+		if err := rec.AssertQueryCount(4); err != nil {
+			t.Error(err)
+		}
+		for _, u := range users {
+			if len(u.Posts) != wantPosts[u.ID] {
+				t.Errorf("user %d: expected %d posts, got %d", u.ID, wantPosts[u.ID], len(u.Posts))
+			}
+		}
+	})
+
+	t.Run("batched", func(t *testing.T) {
+		rec, stop := RecordQueries(ctx)
+		cursor, err := New[RelUser]().SetDB(db).With("Posts").OrderBy("id", "ASC").Cursor(ctx)
+		if err != nil {
+			t.Fatalf("Cursor failed: %v", err)
+		}
+		var users []*RelUser
+		var batches int
+		for {
+			batch, err := cursor.ScanBatch(ctx, 2)
+			if err != nil {
+				t.Fatalf("ScanBatch failed: %v", err)
+			}
+			if len(batch) == 0 {
+				break
+			}
+			batches++
+			users = append(users, batch...)
+		}
+		cursor.Close()
+		stop()
+
+		if batches != 2 || len(users) != 3 {
+			t.Fatalf("expected 3 users in 2 batches, got %d in %d", len(users), batches)
+		}
+		// The cursor query plus one Posts query per batch
+		if err := rec.AssertQueryCount(3); err != nil {
+			t.Error(err)
+		}
+		for _, u := range users {
+			if len(u.Posts) != wantPosts[u.ID] {
+				t.Errorf("user %d: expected %d posts, got %d", u.ID, wantPosts[u.ID], len(u.Posts))
+			}
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		cursor, err := New[RelUser]().SetDB(db).With("Posts").Cursor(ctx)
+		if err != nil {
+			t.Fatalf("Cursor failed: %v", err)
+		}
+		defer cursor.Close()
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		if !cursor.Next() {
+			t.Fatal("expected a row")
+		}
+		if _, err := cursor.Scan(cancelled); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected relation loading to honor the cancelled context, got %v", err)
+		}
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+
+		if _, err := New[RelUser]().WithTx(&Tx{Tx: tx}).With("Posts").Cursor(ctx); err == nil {
+			t.Error("expected Cursor to reject relations inside a transaction")
+		}
+		if _, err := New[RelUser]().WithTx(&Tx{Tx: tx}).WithCount("Posts").Cursor(ctx); err == nil {
+			t.Error("expected Cursor to reject relation counts inside a transaction")
+		}
+		cursor, err := New[RelUser]().WithTx(&Tx{Tx: tx}).Cursor(ctx)
+		if err != nil {
+			t.Fatalf("expected a plain Cursor inside a transaction to work, got %v", err)
+		}
+		cursor.Close()
+	})
+
+	t.Run("EachGroup loads per group", func(t *testing.T) {
+		if _, err := db.Exec(`UPDATE rel_users SET name = 'team' WHERE id IN (1, 2)`); err != nil {
+			t.Fatal(err)
+		}
+
+		rec, stop := RecordQueries(ctx)
+		var sizes []int
+		err := New[RelUser]().SetDB(db).With("Posts").OrderBy("id", "ASC").
+			EachGroup(ctx, []string{"name"}, func(keys []any, users []*RelUser) error {
+				sizes = append(sizes, len(users))
+				for _, u := range users {
+					if len(u.Posts) != wantPosts[u.ID] {
+						t.Errorf("user %d: expected %d posts, got %d", u.ID, wantPosts[u.ID], len(u.Posts))
+					}
+				}
+				return nil
+			})
+		stop()
+		if err != nil {
+			t.Fatalf("EachGroup failed: %v", err)
+		}
+
+		if len(sizes) != 2 || sizes[0] != 1 || sizes[1] != 2 {
+			t.Fatalf("expected group sizes [1 2], got %v", sizes)
+		}
+		// The cursor query plus one Posts query per group
+		if err := rec.AssertQueryCount(3); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestRelations_FindIntoLoadsRelationsInTransaction(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+	// One connection: relation queries deadlock if the main rows stay open
+	db.SetMaxOpenConns(1)
+	SetDialect(DialectSQLite)
+	t.Cleanup(func() { SetDialect(DialectAuto) })
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	dest := &RelUser{ID: 99, Name: "stale"}
+	defer ClearOriginals(dest)
+	if err := New[RelUser]().WithTx(&Tx{Tx: tx}).With("Posts").FindInto(ctx, 1, dest); err != nil {
+		t.Fatalf("FindInto failed: %v", err)
+	}
+	if dest.ID != 1 || len(dest.Posts) == 0 {
+		t.Errorf("expected user 1 with posts, got %+v", dest)
+	}
+	if !IsTracked(dest) {
+		t.Error("expected dest to carry the tracked originals")
+	}
+}
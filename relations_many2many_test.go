@@ -2,6 +2,7 @@ package zorm
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 )
 
@@ -546,3 +547,461 @@ func TestSync_NoChanges(t *testing.T) {
 		t.Errorf("expected roles [1, 2], got %v", roleIDs)
 	}
 }
+
+// TestRelations_Toggle verifies Toggle with partial overlap: IDs already
+// attached are detached, absent IDs are attached, others are untouched.
+func TestRelations_Toggle(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	user := &RelUserExtended{ID: 1} // Alice has Admin(1) and Editor(2)
+
+	_, err := db.Exec("INSERT INTO rel_roles (id, name) VALUES (3, 'Viewer')")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Toggle [2, 3]: Editor(2) is present and gets detached, Viewer(3) is
+	// absent and gets attached, Admin(1) is left alone.
+	attached, detached, err := New[RelUserExtended]().Toggle(ctx, user, "Roles", []any{2, 3}, nil)
+	if err != nil {
+		t.Fatalf("Toggle failed: %v", err)
+	}
+	if len(attached) != 1 || anyToKeyString(attached[0]) != "3" {
+		t.Errorf("expected attached [3], got %v", attached)
+	}
+	if len(detached) != 1 || anyToKeyString(detached[0]) != "2" {
+		t.Errorf("expected detached [2], got %v", detached)
+	}
+
+	rows, err := db.Query("SELECT role_id FROM rel_role_user WHERE user_id = 1 ORDER BY role_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var roleIDs []int
+	for rows.Next() {
+		var id int
+		rows.Scan(&id)
+		roleIDs = append(roleIDs, id)
+	}
+
+	if len(roleIDs) != 2 || roleIDs[0] != 1 || roleIDs[1] != 3 {
+		t.Errorf("expected roles [1, 3], got %v", roleIDs)
+	}
+}
+
+// TestRelations_ToggleRollsBack verifies a failed attach undoes the detach
+// that ran before it.
+func TestRelations_ToggleRollsBack(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	ctx := context.Background()
+	user := &RelUserExtended{ID: 1} // Alice has Admin(1) and Editor(2)
+
+	// Editor(2) is detached first, then attaching Viewer(3) fails on the
+	// unknown pivot column
+	pivotData := map[any]map[string]any{3: {"missing_column": 1}}
+	if _, _, err := New[RelUserExtended]().SetDB(db).Toggle(ctx, user, "Roles", []any{2, 3}, pivotData); err == nil {
+		t.Fatal("expected Toggle to fail on the unknown pivot column")
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM rel_role_user WHERE user_id = 1 AND role_id = 2").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected the detach of role 2 to be rolled back, got %d rows", count)
+	}
+}
+
+func TestToggle_InvalidRelation(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	user := &RelUserExtended{ID: 1}
+
+	_, _, err := New[RelUserExtended]().Toggle(ctx, user, "Posts", []any{1}, nil)
+	if err == nil {
+		t.Error("expected error when toggling non-BelongsToMany relation")
+	}
+}
+
+// TestRelations_UpdateExistingPivot verifies only the targeted pivot row
+// is updated.
+func TestRelations_UpdateExistingPivot(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		ALTER TABLE rel_role_user ADD COLUMN label TEXT;
+		UPDATE rel_role_user SET label = 'original';
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	user := &RelUserExtended{ID: 1} // Alice has Admin(1) and Editor(2)
+
+	err = New[RelUserExtended]().UpdateExistingPivot(ctx, user, "Roles", 2, map[string]any{"label": "updated"})
+	if err != nil {
+		t.Fatalf("UpdateExistingPivot failed: %v", err)
+	}
+
+	rows, err := db.Query("SELECT user_id, role_id, label FROM rel_role_user ORDER BY user_id, role_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID, roleID int
+		var label string
+		if err := rows.Scan(&userID, &roleID, &label); err != nil {
+			t.Fatal(err)
+		}
+		want := "original"
+		if userID == 1 && roleID == 2 {
+			want = "updated"
+		}
+		if label != want {
+			t.Errorf("pivot (%d, %d): expected label %q, got %q", userID, roleID, want, label)
+		}
+	}
+}
+
+// RelUserStamped shares rel_users with RelUserExtended but keeps pivot
+// timestamps on its roles.
+type RelUserStamped struct {
+	ID    int `zorm:"primaryKey"`
+	Name  string
+	Roles []*RelRole
+}
+
+func (u RelUserStamped) TableName() string { return "rel_users" }
+
+func (u RelUserStamped) RolesRelation() BelongsToMany[RelRole] {
+	return BelongsToMany[RelRole]{
+		PivotTable: "rel_role_user",
+		ForeignKey: "user_id",
+		RelatedKey: "role_id",
+		Timestamps: true,
+	}
+}
+
+func TestRelations_PivotTimestamps(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		ALTER TABLE rel_role_user ADD COLUMN created_at TEXT;
+		ALTER TABLE rel_role_user ADD COLUMN updated_at TEXT;
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	user := &RelUserStamped{ID: 1} // Alice has Admin(1) and Editor(2)
+	m := New[RelUserStamped]()
+
+	stamps := func(roleID int) (created, updated sql.NullString) {
+		t.Helper()
+		err := db.QueryRow("SELECT created_at, updated_at FROM rel_role_user WHERE user_id = 1 AND role_id = ?", roleID).Scan(&created, &updated)
+		if err != nil {
+			t.Fatalf("role %d: %v", roleID, err)
+		}
+		return created, updated
+	}
+
+	// Attach fills both columns; explicit pivot data wins
+	explicit := "2020-01-01 00:00:00"
+	pivotData := map[any]map[string]any{4: {"created_at": explicit}}
+	if err := m.Attach(ctx, user, "Roles", []any{3, 4}, pivotData); err != nil {
+		t.Fatalf("Attach failed: %v", err)
+	}
+	if created, updated := stamps(3); !created.Valid || !updated.Valid {
+		t.Errorf("role 3: expected both timestamps set, got %v / %v", created, updated)
+	}
+	if created, updated := stamps(4); created.String != explicit || !updated.Valid {
+		t.Errorf("role 4: expected explicit created_at and auto updated_at, got %v / %v", created, updated)
+	}
+
+	// UpdateExistingPivot bumps updated_at only
+	if err := m.UpdateExistingPivot(ctx, user, "Roles", 1, nil); err != nil {
+		t.Fatalf("UpdateExistingPivot failed: %v", err)
+	}
+	if created, updated := stamps(1); created.Valid || !updated.Valid {
+		t.Errorf("role 1: expected only updated_at set, got %v / %v", created, updated)
+	}
+	if err := m.UpdateExistingPivot(ctx, user, "Roles", 1, map[string]any{"updated_at": explicit}); err != nil {
+		t.Fatalf("UpdateExistingPivot(explicit) failed: %v", err)
+	}
+	if _, updated := stamps(1); updated.String != explicit {
+		t.Errorf("role 1: expected explicit updated_at, got %v", updated)
+	}
+
+	// Sync touches kept rows and stamps new ones
+	if _, err := db.Exec("UPDATE rel_role_user SET updated_at = NULL"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Sync(ctx, user, "Roles", []any{1, 3, 5}, nil); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	for _, roleID := range []int{1, 3, 5} {
+		if _, updated := stamps(roleID); !updated.Valid {
+			t.Errorf("role %d: expected updated_at bumped by Sync", roleID)
+		}
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM rel_role_user WHERE user_id = 1").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 associations after Sync, got %d", count)
+	}
+}
+
+func TestUpdateExistingPivot_InvalidInput(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	ctx := context.Background()
+	user := &RelUserExtended{ID: 1}
+
+	err := New[RelUserExtended]().UpdateExistingPivot(ctx, user, "Posts", 1, map[string]any{"label": "x"})
+	if err == nil {
+		t.Error("expected error when updating pivot of non-BelongsToMany relation")
+	}
+
+	err = New[RelUserExtended]().UpdateExistingPivot(ctx, user, "Roles", 1, map[string]any{"label; DROP TABLE rel_roles": "x"})
+	if err == nil {
+		t.Error("expected error for invalid pivot column name")
+	}
+}
+
+func TestRelations_WithPivotOrderBy(t *testing.T) {
+	db := setupRelDBExtended(t)
+	defer db.Close()
+
+	oldDB := GlobalDB
+	GlobalDB = db
+	defer func() { GlobalDB = oldDB }()
+
+	_, err := db.Exec(`
+		ALTER TABLE rel_role_user ADD COLUMN priority INTEGER;
+		INSERT INTO rel_roles (id, name) VALUES (3, 'Viewer');
+		INSERT INTO rel_role_user (user_id, role_id) VALUES (1, 3);
+		UPDATE rel_role_user SET priority = 2 WHERE role_id = 1;
+		UPDATE rel_role_user SET priority = 3 WHERE role_id = 2;
+		UPDATE rel_role_user SET priority = 1 WHERE role_id = 3;
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	users, err := New[RelUserExtended]().WithPivotOrderBy("Roles", "rel_role_user.priority", "ASC").Where("id", 1).Get(ctx)
+	if err != nil {
+		t.Fatalf("WithPivotOrderBy failed: %v", err)
+	}
+
+	want := []int{3, 1, 2}
+	roles := users[0].Roles
+	if len(roles) != len(want) {
+		t.Fatalf("expected %d roles, got %d", len(want), len(roles))
+	}
+	for i, r := range roles {
+		if r.ID != want[i] {
+			t.Errorf("position %d: expected role %d, got %d", i, want[i], r.ID)
+		}
+	}
+
+	users, err = New[RelUserExtended]().WithPivotOrderBy("Roles", "priority", "desc").Where("id", 1).Get(ctx)
+	if err != nil {
+		t.Fatalf("WithPivotOrderBy DESC failed: %v", err)
+	}
+	if users[0].Roles[0].ID != 2 {
+		t.Errorf("expected role 2 first for DESC, got %d", users[0].Roles[0].ID)
+	}
+
+	// A relation already passed to With is not loaded a second time
+	q := New[RelUserExtended]().With("Roles").WithPivotOrderBy("Roles", "priority", "ASC")
+	if len(q.relations) != 1 {
+		t.Fatalf("expected Roles once in the eager-load set, got %v", q.relations)
+	}
+	users, err = q.Where("id", 1).Get(ctx)
+	if err != nil {
+		t.Fatalf("With + WithPivotOrderBy failed: %v", err)
+	}
+	if len(users[0].Roles) != 3 || users[0].Roles[0].ID != 3 {
+		t.Errorf("expected 3 roles ordered by priority, got %+v", users[0].Roles)
+	}
+}
+
+func TestWithPivotOrderBy_Invalid(t *testing.T) {
+	if m := New[RelUserExtended]().WithPivotOrderBy("Roles", "priority; DROP", "ASC"); m.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+	if m := New[RelUserExtended]().WithPivotOrderBy("Roles", "priority", "SIDEWAYS"); m.buildErr == nil {
+		t.Error("expected buildErr for invalid direction")
+	}
+}
+
+// ==================== MorphToMany / MorphedByMany ====================
+
+type RelTag struct {
+	ID     int `zorm:"primaryKey"`
+	Name   string
+	Posts  []*RelTaggedPost
+	Videos []*RelTaggedVideo
+}
+
+func (RelTag) TableName() string { return "rel_tags" }
+
+func (RelTag) PostsRelation() MorphedByMany[RelTaggedPost] {
+	return MorphedByMany[RelTaggedPost]{PivotTable: "rel_taggables", Type: "taggable_type", ID: "taggable_id", ForeignKey: "tag_id"}
+}
+
+func (RelTag) VideosRelation() MorphedByMany[RelTaggedVideo] {
+	return MorphedByMany[RelTaggedVideo]{PivotTable: "rel_taggables", Type: "taggable_type", ID: "taggable_id", ForeignKey: "tag_id"}
+}
+
+type RelTaggedPost struct {
+	ID    int `zorm:"primaryKey"`
+	Title string
+	Tags  []*RelTag
+}
+
+func (RelTaggedPost) TableName() string { return "rel_tagged_posts" }
+
+func (RelTaggedPost) TagsRelation() MorphToMany[RelTag] {
+	return MorphToMany[RelTag]{PivotTable: "rel_taggables", Type: "taggable_type", ID: "taggable_id", RelatedKey: "tag_id"}
+}
+
+type RelTaggedVideo struct {
+	ID    int `zorm:"primaryKey"`
+	Title string
+	Tags  []*RelTag
+}
+
+func (RelTaggedVideo) TableName() string { return "rel_tagged_videos" }
+
+func (RelTaggedVideo) TagsRelation() MorphToMany[RelTag] {
+	return MorphToMany[RelTag]{PivotTable: "rel_taggables", Type: "taggable_type", ID: "taggable_id", RelatedKey: "tag_id"}
+}
+
+func TestRelations_MorphToMany(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Post 1 and Video 1 share an ID, so only the type column tells their
+	// pivot rows apart. Tag 1 is attached to both.
+	_, err = db.Exec(`
+		CREATE TABLE rel_tags (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE rel_tagged_posts (id INTEGER PRIMARY KEY, title TEXT);
+		CREATE TABLE rel_tagged_videos (id INTEGER PRIMARY KEY, title TEXT);
+		CREATE TABLE rel_taggables (tag_id INTEGER, taggable_id INTEGER, taggable_type TEXT);
+
+		INSERT INTO rel_tags (id, name) VALUES (1, 'go'), (2, 'sql');
+		INSERT INTO rel_tagged_posts (id, title) VALUES (1, 'Post 1'), (2, 'Post 2');
+		INSERT INTO rel_tagged_videos (id, title) VALUES (1, 'Video 1');
+		INSERT INTO rel_taggables (tag_id, taggable_id, taggable_type) VALUES
+		(1, 1, 'RelTaggedPost'),
+		(2, 1, 'RelTaggedPost'),
+		(2, 2, 'RelTaggedPost'),
+		(1, 1, 'RelTaggedVideo');
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	posts, err := New[RelTaggedPost]().SetDB(db).With("Tags").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("load post tags failed: %v", err)
+	}
+	if len(posts) != 2 || len(posts[0].Tags) != 2 || len(posts[1].Tags) != 1 || posts[1].Tags[0].Name != "sql" {
+		t.Errorf("unexpected post tags: %+v", posts)
+	}
+
+	videos, err := New[RelTaggedVideo]().SetDB(db).With("Tags").Get(ctx)
+	if err != nil {
+		t.Fatalf("load video tags failed: %v", err)
+	}
+	if len(videos) != 1 || len(videos[0].Tags) != 1 || videos[0].Tags[0].Name != "go" {
+		t.Errorf("expected video 1 tagged only 'go', got %+v", videos)
+	}
+
+	tags, err := New[RelTag]().SetDB(db).With("Posts", "Videos").OrderBy("id", "ASC").Get(ctx)
+	if err != nil {
+		t.Fatalf("load tag parents failed: %v", err)
+	}
+	if len(tags) != 2 {
+		t.Fatalf("expected 2 tags, got %d", len(tags))
+	}
+	if len(tags[0].Posts) != 1 || tags[0].Posts[0].ID != 1 || len(tags[0].Videos) != 1 || tags[0].Videos[0].Title != "Video 1" {
+		t.Errorf("tag 'go': unexpected parents posts=%+v videos=%+v", tags[0].Posts, tags[0].Videos)
+	}
+	if len(tags[1].Posts) != 2 || len(tags[1].Videos) != 0 {
+		t.Errorf("tag 'sql': unexpected parents posts=%+v videos=%+v", tags[1].Posts, tags[1].Videos)
+	}
+}
+
+func TestMorphToMany_InvalidConfig(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE rel_morph_bad (id INTEGER PRIMARY KEY); INSERT INTO rel_morph_bad (id) VALUES (1);`); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = New[RelMorphBad]().SetDB(db).With("Tags").Get(context.Background())
+	if err == nil {
+		t.Error("expected error for invalid morph type column")
+	}
+}
+
+type RelMorphBad struct {
+	ID   int `zorm:"primaryKey"`
+	Tags []*RelTag
+}
+
+func (RelMorphBad) TableName() string { return "rel_morph_bad" }
+
+func (RelMorphBad) TagsRelation() MorphToMany[RelTag] {
+	return MorphToMany[RelTag]{PivotTable: "rel_taggables", Type: "taggable_type; DROP TABLE rel_tags", ID: "taggable_id"}
+}
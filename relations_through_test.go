@@ -0,0 +1,262 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// ==================== Models for HasManyThrough with INT keys ====================
+
+type ThroughContinent struct {
+	ID        int `zorm:"primaryKey"`
+	Name      string
+	Countries []*ThroughCountry
+}
+
+func (ThroughContinent) TableName() string { return "through_continents" }
+
+func (ThroughContinent) CountriesRelation() HasMany[ThroughCountry] {
+	return HasMany[ThroughCountry]{ForeignKey: "continent_id"}
+}
+
+type ThroughCountry struct {
+	ID          int `zorm:"primaryKey"`
+	ContinentID int
+	Name        string
+	Posts       []*ThroughPost
+}
+
+func (ThroughCountry) TableName() string { return "through_countries" }
+
+func (ThroughCountry) PostsRelation() HasManyThrough[ThroughPost] {
+	return HasManyThrough[ThroughPost]{
+		Through:   "through_users",
+		FirstKey:  "country_id",
+		SecondKey: "user_id",
+	}
+}
+
+type ThroughPost struct {
+	ID       int `zorm:"primaryKey"`
+	UserID   int
+	Title    string
+	Comments []ThroughComment
+}
+
+func (ThroughPost) TableName() string { return "through_posts" }
+
+func (ThroughPost) CommentsRelation() HasMany[ThroughComment] {
+	return HasMany[ThroughComment]{ForeignKey: "post_id"}
+}
+
+type ThroughComment struct {
+	ID     int `zorm:"primaryKey"`
+	PostID int
+	Body   string
+}
+
+func (ThroughComment) TableName() string { return "through_comments" }
+
+// ==================== Models for HasManyThrough with UUID keys ====================
+
+type ThroughCountryUUID struct {
+	ID    uuid.UUID `zorm:"primaryKey"`
+	Name  string
+	Posts []ThroughPostUUID
+}
+
+func (ThroughCountryUUID) TableName() string { return "through_countries_uuid" }
+
+func (ThroughCountryUUID) PostsRelation() HasManyThrough[ThroughPostUUID] {
+	return HasManyThrough[ThroughPostUUID]{
+		Through:   "through_users_uuid",
+		FirstKey:  "country_id",
+		SecondKey: "user_id",
+	}
+}
+
+type ThroughPostUUID struct {
+	ID     uuid.UUID `zorm:"primaryKey"`
+	UserID uuid.UUID
+	Title  string
+}
+
+func (ThroughPostUUID) TableName() string { return "through_posts_uuid" }
+
+// ==================== Setup Functions ====================
+
+func setupThroughIntDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE through_continents (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE through_countries (id INTEGER PRIMARY KEY, continent_id INTEGER, name TEXT);
+		CREATE TABLE through_users (id INTEGER PRIMARY KEY, country_id INTEGER, name TEXT);
+		CREATE TABLE through_posts (id INTEGER PRIMARY KEY, user_id INTEGER, title TEXT);
+		CREATE TABLE through_comments (id INTEGER PRIMARY KEY, post_id INTEGER, body TEXT);
+
+		INSERT INTO through_continents (id, name) VALUES (1, 'Europe');
+		INSERT INTO through_countries (id, continent_id, name) VALUES (1, 1, 'France'), (2, 1, 'Spain'), (3, 1, 'Italy');
+		INSERT INTO through_users (id, country_id, name) VALUES (1, 1, 'Alice'), (2, 1, 'Bob'), (3, 2, 'Carlos');
+		INSERT INTO through_posts (id, user_id, title) VALUES
+			(1, 1, 'Alice 1'),
+			(2, 1, 'Alice 2'),
+			(3, 2, 'Bob 1'),
+			(4, 3, 'Carlos 1');
+		INSERT INTO through_comments (id, post_id, body) VALUES (1, 1, 'first'), (2, 4, 'hola');
+	`)
+	if err != nil {
+		t.Fatalf("failed to set up tables: %v", err)
+	}
+	return db
+}
+
+// ==================== Tests ====================
+
+func TestHasManyThrough_IntKeys(t *testing.T) {
+	db := setupThroughIntDB(t)
+	defer db.Close()
+
+	countries, err := New[ThroughCountry]().SetDB(db).
+		With("Posts.Comments").
+		OrderBy("id", "ASC").
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get with HasManyThrough failed: %v", err)
+	}
+	if len(countries) != 3 {
+		t.Fatalf("expected 3 countries, got %d", len(countries))
+	}
+
+	if got := len(countries[0].Posts); got != 3 {
+		t.Errorf("expected France to have 3 posts, got %d", got)
+	}
+	if got := len(countries[1].Posts); got != 1 || countries[1].Posts[0].Title != "Carlos 1" {
+		t.Errorf("expected Spain to have Carlos's post, got %+v", countries[1].Posts)
+	}
+	if countries[2].Posts != nil {
+		t.Errorf("expected Italy to have no posts, got %+v", countries[2].Posts)
+	}
+
+	comments := 0
+	for _, p := range countries[0].Posts {
+		comments += len(p.Comments)
+	}
+	if comments != 1 {
+		t.Errorf("expected nested comments to load for France's posts, got %d", comments)
+	}
+	if len(countries[1].Posts[0].Comments) != 1 {
+		t.Errorf("expected Carlos's post to have 1 comment, got %+v", countries[1].Posts[0].Comments)
+	}
+}
+
+func TestHasManyThrough_NestedUnderHasMany(t *testing.T) {
+	db := setupThroughIntDB(t)
+	defer db.Close()
+
+	continent, err := New[ThroughContinent]().SetDB(db).
+		With("Countries.Posts").
+		First(context.Background())
+	if err != nil {
+		t.Fatalf("First failed: %v", err)
+	}
+	if len(continent.Countries) != 3 {
+		t.Fatalf("expected 3 countries, got %d", len(continent.Countries))
+	}
+
+	total := 0
+	for _, c := range continent.Countries {
+		total += len(c.Posts)
+	}
+	if total != 4 {
+		t.Errorf("expected 4 posts across countries, got %d", total)
+	}
+}
+
+func TestHasManyThrough_EmptyParentSet(t *testing.T) {
+	db := setupThroughIntDB(t)
+	defer db.Close()
+
+	countries, err := New[ThroughCountry]().SetDB(db).
+		With("Posts").
+		Where("id", 999).
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error for an empty parent set, got %v", err)
+	}
+	if len(countries) != 0 {
+		t.Errorf("expected no countries, got %d", len(countries))
+	}
+
+	if err := New[ThroughCountry]().SetDB(db).LoadSlice(context.Background(), nil, "Posts"); err != nil {
+		t.Errorf("expected LoadSlice on no entities to succeed, got %v", err)
+	}
+}
+
+func TestHasManyThrough_UUIDKeys(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`
+		CREATE TABLE through_countries_uuid (id TEXT PRIMARY KEY, name TEXT);
+		CREATE TABLE through_users_uuid (id TEXT PRIMARY KEY, country_id TEXT, name TEXT);
+		CREATE TABLE through_posts_uuid (id TEXT PRIMARY KEY, user_id TEXT, title TEXT);
+	`); err != nil {
+		t.Fatalf("failed to create tables: %v", err)
+	}
+
+	france, spain := uuid.New(), uuid.New()
+	alice, carlos := uuid.New(), uuid.New()
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := db.Exec(query, args...); err != nil {
+			t.Fatalf("insert failed: %v", err)
+		}
+	}
+	exec(`INSERT INTO through_countries_uuid (id, name) VALUES (?, 'France'), (?, 'Spain')`, france.String(), spain.String())
+	exec(`INSERT INTO through_users_uuid (id, country_id, name) VALUES (?, ?, 'Alice'), (?, ?, 'Carlos')`,
+		alice.String(), france.String(), carlos.String(), spain.String())
+	exec(`INSERT INTO through_posts_uuid (id, user_id, title) VALUES (?, ?, 'Alice 1'), (?, ?, 'Alice 2'), (?, ?, 'Carlos 1')`,
+		uuid.NewString(), alice.String(), uuid.NewString(), alice.String(), uuid.NewString(), carlos.String())
+
+	countries, err := New[ThroughCountryUUID]().SetDB(db).With("Posts").Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get with HasManyThrough failed: %v", err)
+	}
+	if len(countries) != 2 {
+		t.Fatalf("expected 2 countries, got %d", len(countries))
+	}
+	for _, c := range countries {
+		want := map[uuid.UUID]int{france: 2, spain: 1}[c.ID]
+		if len(c.Posts) != want {
+			t.Errorf("%s: expected %d posts, got %d", c.Name, want, len(c.Posts))
+		}
+		for _, p := range c.Posts {
+			if p.UserID != alice && p.UserID != carlos {
+				t.Errorf("%s: unexpected post %+v", c.Name, p)
+			}
+		}
+	}
+}
+
+func TestHasManyThrough_RequiresThroughAndSecondKey(t *testing.T) {
+	rel := HasManyThrough[ThroughPost]{FirstKey: "country_id"}
+	if rel.RelationType() != RelationHasManyThrough {
+		t.Errorf("expected %v, got %v", RelationHasManyThrough, rel.RelationType())
+	}
+
+	m := New[ThroughCountry]()
+	if _, err := m.loadThrough(context.Background(), []any{&ThroughCountry{ID: 1}}, m.modelInfo, rel, "Posts", "", nil, nil); err == nil {
+		t.Error("expected an error when Through and SecondKey are missing")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/google/uuid"
@@ -550,6 +551,21 @@ func TestMorphMany_NewModel(t *testing.T) {
 	}
 }
 
+func TestMorphToMany_RelationType(t *testing.T) {
+	if got := (MorphToMany[RelTestModel]{}).RelationType(); got != RelationMorphToMany {
+		t.Errorf("MorphToMany.RelationType() = %v, want %v", got, RelationMorphToMany)
+	}
+	if got := (MorphedByMany[RelTestModel]{}).RelationType(); got != RelationMorphedByMany {
+		t.Errorf("MorphedByMany.RelationType() = %v, want %v", got, RelationMorphedByMany)
+	}
+	if _, ok := (MorphToMany[RelTestModel]{}).NewRelated().(*RelTestModel); !ok {
+		t.Error("MorphToMany.NewRelated() should return *RelTestModel")
+	}
+	if _, ok := (MorphedByMany[RelTestModel]{}).NewModel(context.Background(), nil).(*Model[RelTestModel]); !ok {
+		t.Error("MorphedByMany.NewModel() should return *Model[RelTestModel]")
+	}
+}
+
 // ==================== GetOverrideTable Tests ====================
 
 func TestHasOne_GetOverrideTable(t *testing.T) {
@@ -801,3 +817,84 @@ func TestAnyToKeyString_CustomStruct(t *testing.T) {
 		t.Errorf("anyToKeyString(%+v) = %q, want %q", input, result, expected)
 	}
 }
+
+func TestWithRequiredColumns(t *testing.T) {
+	tests := []struct {
+		cols     string
+		required []string
+		want     string
+	}{
+		{"", []string{"user_id"}, ""},
+		{"title", []string{"user_id"}, "title, user_id"},
+		{"id,title,user_id", []string{"user_id"}, "id,title,user_id"},
+		{"id, posts.USER_ID", []string{"user_id"}, "id, posts.USER_ID"},
+		{"*", []string{"user_id"}, "*"},
+		{"posts.*", []string{"user_id"}, "posts.*"},
+		{"title", []string{"id", "user_id"}, "title, id, user_id"},
+	}
+	for _, tt := range tests {
+		if got := withRequiredColumns(tt.cols, tt.required...); got != tt.want {
+			t.Errorf("withRequiredColumns(%q, %v) = %q, want %q", tt.cols, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestSetRelatedField(t *testing.T) {
+	children := []reflect.Value{
+		reflect.ValueOf(&RelTestModel{ID: 1}),
+		reflect.ValueOf(&RelTestModel{ID: 2}),
+	}
+
+	var target struct {
+		Many   []*RelTestModel
+		Values []RelTestModel
+		One    *RelTestModel
+		Plain  RelTestModel
+	}
+	v := reflect.ValueOf(&target).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		setRelatedField(v.Field(i), children)
+	}
+
+	if len(target.Many) != 2 || target.Many[1].ID != 2 {
+		t.Errorf("Many = %+v, want both children", target.Many)
+	}
+	if len(target.Values) != 2 || target.Values[0].ID != 1 {
+		t.Errorf("Values = %+v, want both children", target.Values)
+	}
+	if target.One == nil || target.One.ID != 1 {
+		t.Errorf("One = %+v, want the first child", target.One)
+	}
+	if target.Plain.ID != 1 {
+		t.Errorf("Plain = %+v, want the first child", target.Plain)
+	}
+}
+
+func TestRelationOrder(t *testing.T) {
+	tests := []struct {
+		order   string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false},
+		{"created_at DESC", "created_at DESC", false},
+		{" created_at desc , id ", "created_at DESC, id", false},
+		{"posts.created_at asc", "posts.created_at ASC", false},
+		{"created_at SIDEWAYS", "", true},
+		{"created_at; DROP TABLE users", "", true},
+	}
+	for _, tt := range tests {
+		got, err := relationOrder(reflect.ValueOf(HasMany[RelTestModel]{OrderBy: tt.order}))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("relationOrder(%q) error = %v, wantErr %v", tt.order, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("relationOrder(%q) = %q, want %q", tt.order, got, tt.want)
+		}
+	}
+
+	if got, err := relationOrder(reflect.ValueOf(HasOne[RelTestModel]{})); got != "" || err != nil {
+		t.Errorf("relationOrder(HasOne) = %q, %v; want no order", got, err)
+	}
+}
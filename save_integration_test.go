@@ -202,16 +202,38 @@ func TestSave_OptimisticLockConflict(t *testing.T) {
 	}
 }
 
-func TestSave_RequiresPK(t *testing.T) {
+func TestSave_InsertsWhenPKZero(t *testing.T) {
 	db := setupSaveDB(t)
 	defer db.Close()
 
 	ctx := context.Background()
-	row := &saveItem{Name: "no-pk", Value: 1}
+	row := &saveItem{Name: "fresh", Value: 1}
+	// Tracking is keyed by address; untrack so a later test's entity that
+	// reuses this address is not mistaken for a tracked one.
+	defer ClearOriginals(row)
 
-	err := New[saveItem]().SetDB(db).Save(ctx, row)
-	if err == nil {
-		t.Fatal("expected error for zero PK, got nil")
+	if err := New[saveItem]().SetDB(db).Save(ctx, row); err != nil {
+		t.Fatalf("Save (insert): %v", err)
+	}
+	if row.ID == 0 {
+		t.Fatal("expected primary key to be populated after insert")
+	}
+	if !IsTracked(row) {
+		t.Fatal("expected inserted entity to be tracked")
+	}
+
+	// Saving again updates the same row instead of inserting a duplicate.
+	row.Value = 2
+	if err := New[saveItem]().SetDB(db).Save(ctx, row); err != nil {
+		t.Fatalf("Save (update): %v", err)
+	}
+
+	var count, value int
+	if err := db.QueryRow(`SELECT COUNT(*), MAX(value) FROM save_items WHERE name = 'fresh'`).Scan(&count, &value); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if count != 1 || value != 2 {
+		t.Errorf("expected one row with value 2, got %d rows, value %d", count, value)
 	}
 }
 
@@ -60,6 +60,11 @@ func (q *ScalarQuery[T]) effectiveDialect() Dialect {
 	return detectDialect(db)
 }
 
+// rebind rewrites query's `?` placeholders for the query's dialect.
+func (q *ScalarQuery[T]) rebind(query string) string {
+	return q.effectiveDialect().Rebind(query)
+}
+
 // Table sets the table name for the query.
 // Table names are validated to prevent SQL injection.
 func (q *ScalarQuery[T]) Table(name string) *ScalarQuery[T] {
@@ -184,6 +189,56 @@ func (q *ScalarQuery[T]) WhereNotIn(column string, values []any) *ScalarQuery[T]
 	return q
 }
 
+// OrWhereNotIn adds an OR column NOT IN (...) condition. An empty values
+// slice adds OR 1=1, which matches every row.
+func (q *ScalarQuery[T]) OrWhereNotIn(column string, values []any) *ScalarQuery[T] {
+	if err := ValidateColumnName(column); err != nil {
+		q.buildErr = fmt.Errorf("zorm: ScalarQuery.OrWhereNotIn: invalid column %q: %w", column, err)
+		return q
+	}
+	frag, outArgs, err := buildNotInClause(column, values, q.effectiveDialect())
+	if err != nil {
+		q.buildErr = fmt.Errorf("zorm: ScalarQuery.OrWhereNotIn: %w", err)
+		return q
+	}
+	q.wheres = append(q.wheres, "OR "+frag)
+	q.args = append(q.args, outArgs...)
+	return q
+}
+
+// WhereBetween adds a WHERE column BETWEEN low AND high condition.
+func (q *ScalarQuery[T]) WhereBetween(column string, low, high any) *ScalarQuery[T] {
+	if err := ValidateColumnName(column); err != nil {
+		q.buildErr = fmt.Errorf("zorm: ScalarQuery.WhereBetween: invalid column %q: %w", column, err)
+		return q
+	}
+	q.wheres = append(q.wheres, "AND "+column+" BETWEEN ? AND ?")
+	q.args = append(q.args, low, high)
+	return q
+}
+
+// OrWhereBetween adds an OR column BETWEEN low AND high condition.
+func (q *ScalarQuery[T]) OrWhereBetween(column string, low, high any) *ScalarQuery[T] {
+	if err := ValidateColumnName(column); err != nil {
+		q.buildErr = fmt.Errorf("zorm: ScalarQuery.OrWhereBetween: invalid column %q: %w", column, err)
+		return q
+	}
+	q.wheres = append(q.wheres, "OR "+column+" BETWEEN ? AND ?")
+	q.args = append(q.args, low, high)
+	return q
+}
+
+// WhereNotBetween adds a WHERE column NOT BETWEEN low AND high condition.
+func (q *ScalarQuery[T]) WhereNotBetween(column string, low, high any) *ScalarQuery[T] {
+	if err := ValidateColumnName(column); err != nil {
+		q.buildErr = fmt.Errorf("zorm: ScalarQuery.WhereNotBetween: invalid column %q: %w", column, err)
+		return q
+	}
+	q.wheres = append(q.wheres, "AND "+column+" NOT BETWEEN ? AND ?")
+	q.args = append(q.args, low, high)
+	return q
+}
+
 // WhereNull adds a WHERE column IS NULL condition.
 func (q *ScalarQuery[T]) WhereNull(column string) *ScalarQuery[T] {
 	if err := ValidateColumnName(column); err != nil {
@@ -283,7 +338,7 @@ func (q *ScalarQuery[T]) Get(ctx context.Context) ([]T, error) {
 	}
 	query := q.buildQuery()
 
-	rows, err := q.queryer().QueryContext(ctx, rebind(query), q.args...)
+	rows, err := q.queryer().QueryContext(ctx, q.rebind(query), q.args...)
 	if err != nil {
 		return nil, WrapQueryError("SELECT", query, q.args, err)
 	}
@@ -346,7 +401,7 @@ func (q *ScalarQuery[T]) Count(ctx context.Context) (int64, error) {
 	query := strings.Clone(sb.String())
 
 	var count int64
-	err := q.queryer().QueryRowContext(ctx, rebind(query), q.args...).Scan(&count)
+	err := q.queryer().QueryRowContext(ctx, q.rebind(query), q.args...).Scan(&count)
 	if err != nil {
 		return 0, WrapQueryError("COUNT", query, q.args, err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"testing"
@@ -262,6 +263,66 @@ func TestScalarQuery_WhereIn(t *testing.T) {
 	}
 }
 
+func TestScalarQuery_WhereBetween(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	names, err := Query[string]().
+		SetDB(db).
+		Table("users").
+		Select("name").
+		WhereBetween("age", 25, 30).
+		OrderBy("age", "ASC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if fmt.Sprint(names) != "[Bob Diana Alice]" {
+		t.Errorf("expected [Bob Diana Alice], got %v", names)
+	}
+
+	names, err = Query[string]().
+		SetDB(db).
+		Table("users").
+		Select("name").
+		WhereNotBetween("age", 25, 30).
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if fmt.Sprint(names) != "[Charlie]" {
+		t.Errorf("expected [Charlie], got %v", names)
+	}
+}
+
+func TestScalarQuery_OrWhereBetween(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	names, err := Query[string]().
+		SetDB(db).
+		Table("users").
+		Select("name").
+		Where("name", "Charlie").
+		OrWhereBetween("age", 25, 28).
+		OrderBy("age", "ASC").
+		Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	// name = Charlie (35) OR age BETWEEN 25 AND 28 (Bob, Diana)
+	if fmt.Sprint(names) != "[Bob Diana Charlie]" {
+		t.Errorf("expected [Bob Diana Charlie], got %v", names)
+	}
+
+	q := Query[string]().Table("users").OrWhereBetween("age; DROP TABLE users", 1, 2)
+	if q.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
 func TestScalarQuery_WhereIn_Empty(t *testing.T) {
 	db := setupScalarTestDB(t)
 	defer db.Close()
@@ -1786,11 +1847,47 @@ func TestScalarQuery_WhereNotIn_SQLiteRejectsOverflow(t *testing.T) {
 	if !strings.Contains(err.Error(), "ScalarQuery.WhereNotIn") {
 		t.Fatalf("expected ScalarQuery.WhereNotIn error, got: %v", err)
 	}
-	if !strings.Contains(err.Error(), "exceeds PostgreSQL parameter limit") {
+	if !strings.Contains(err.Error(), "exceeds SQLite parameter limit 32766") {
 		t.Fatalf("expected limit error, got: %v", err)
 	}
 }
 
+func TestScalarQuery_OrWhereNotIn(t *testing.T) {
+	db := setupScalarTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	names, err := Query[string]().
+		SetDB(db).
+		Table("users").
+		Select("name").
+		Where("id", 1).
+		OrWhereNotIn("role", []any{"admin"}).
+		OrderBy("id", "ASC").
+		Get(ctx)
+
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	// id=1 (Alice) OR role NOT IN ('admin') (Bob, Charlie)
+	expected := []string{"Alice", "Bob", "Charlie"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %d names, got %d", len(expected), len(names))
+	}
+
+	for i, name := range names {
+		if name != expected[i] {
+			t.Errorf("expected names[%d] = %q, got %q", i, expected[i], name)
+		}
+	}
+
+	q := Query[string]().Table("users").OrWhereNotIn("role; DROP TABLE users", []any{"x"})
+	if q.buildErr == nil {
+		t.Error("expected buildErr for invalid column")
+	}
+}
+
 func TestScalarQuery_LimitOffset_Combined(t *testing.T) {
 	db := setupScalarTestDB(t)
 	defer db.Close()
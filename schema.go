@@ -3,6 +3,7 @@ package zorm
 import (
 	"container/list"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"reflect"
@@ -11,6 +12,8 @@ import (
 	"sync"
 	"time"
 	"unicode"
+
+	"github.com/google/uuid"
 )
 
 // sbPool is a sync.Pool for strings.Builder to reduce allocations.
@@ -243,14 +246,22 @@ type ModelInfo struct {
 	TableName       string
 	PrimaryKey      string
 	Fields          map[string]*FieldInfo // StructFieldName -> FieldInfo
+	FieldOrder      []*FieldInfo          // Column fields in struct declaration order (embedded fields inline)
 	Columns         map[string]*FieldInfo // DBColumnName -> FieldInfo
 	RelationFields  map[string][]int      // FieldName -> field index for FieldByIndex (relation fields)
 	Accessors       []int                 // Indices of methods starting with "Get"
 	RelationMethods map[string]int        // MethodName -> Index
+	// TagRelations holds relations declared with a `zorm:"belongsTo;..."`
+	// style tag on the relation field, keyed by field name. Relation methods
+	// take precedence over them.
+	TagRelations map[string]tagRelation
 	// VersionField, when non-nil, points to a field flagged with the `version`
 	// tag modifier. Save() uses it for optimistic concurrency control:
 	// the UPDATE checks the current version in WHERE and increments it.
 	VersionField *FieldInfo
+	// DefaultRelations lists relations eager loaded by every query built
+	// with New or Acquire, taken from the model's DefaultRelations method.
+	DefaultRelations []string
 }
 
 // FieldInfo holds data about a single field in the model.
@@ -265,7 +276,19 @@ type FieldInfo struct {
 	// fields callers must fall back to reflect.Value.FieldByIndex.
 	Offset    uintptr // 8 bytes
 	IsPrimary bool    // 1 byte
-	IsAuto    bool    // 1 byte + 6 padding
+	IsAuto    bool    // 1 byte
+	// IsUUID marks a field that Create fills with a new UUID when it is
+	// zero: a uuid.UUID primary key, or any field tagged `uuid`.
+	IsUUID bool // 1 byte
+	// IsJSON marks a field tagged `json`: it is stored as its JSON encoding
+	// and decoded on scan.
+	IsJSON bool // 1 byte
+	// IsArray marks a []string, []int64 or []int field tagged `array`,
+	// stored in a PostgreSQL array column.
+	IsArray bool // 1 byte
+	// addrValuer is set when only *FieldType implements driver.Valuer, so
+	// the field must be bound by address for its Value method to run.
+	addrValuer bool // 1 byte + 2 padding
 }
 
 // GetRelationField returns the reflect.Value for a relation field by name.
@@ -318,6 +341,7 @@ func ParseModelType(typ reflect.Type) *ModelInfo {
 		Columns:         make(map[string]*FieldInfo),
 		RelationFields:  make(map[string][]int),
 		RelationMethods: make(map[string]int),
+		TagRelations:    make(map[string]tagRelation),
 	}
 
 	// 1. Determine Table Name
@@ -330,6 +354,11 @@ func ParseModelType(typ reflect.Type) *ModelInfo {
 		info.TableName = ToSnakeCase(typ.Name()) + "s" // Simple pluralization
 	}
 
+	// Default eager loads; Without and WithOnly adjust them per query
+	if defaulter, ok := ptrVal.Interface().(interface{ DefaultRelations() []string }); ok {
+		info.DefaultRelations = defaulter.DefaultRelations()
+	}
+
 	// 2. Determine Primary Key
 	// Check if T implements PrimaryKey() string
 	if primaryKeyer, ok := ptrVal.Interface().(interface{ PrimaryKey() string }); ok {
@@ -371,29 +400,39 @@ func parseFields(typ reflect.Type, info *ModelInfo, indexPrefix []int) {
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
 
-		// Skip unexported fields
-		if field.PkgPath != "" {
+		// Handle Embedded Structs: their exported fields are flattened into
+		// the parent's columns, even when the embedded type itself is
+		// unexported, unless the embedding is tagged zorm:"-".
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && !isValueObject(field.Type) {
+			if field.Tag.Get("zorm") == "-" {
+				continue
+			}
+			newIndex := append(indexPrefix, i)
+			parseFields(field.Type, info, newIndex)
 			continue
 		}
 
-		// Handle Embedded Structs
-		if field.Anonymous && field.Type.Kind() == reflect.Struct {
-			newIndex := append(indexPrefix, i)
-			parseFields(field.Type, info, newIndex)
+		// Skip unexported fields
+		if field.PkgPath != "" {
 			continue
 		}
 
-		// Record relation fields for efficient FieldByIndex lookups during relation loading
-		if isRelationField(field.Type) {
+		tag := field.Tag.Get("zorm")
+
+		// Record relation fields for efficient FieldByIndex lookups during relation loading.
+		// A `json` tag makes struct pointers and slices of structs plain columns.
+		if isRelationField(field.Type) && !hasTagToken(tag, "json") {
 			currentIndex := append(indexPrefix, i)
 			finalIndex := make([]int, len(currentIndex))
 			copy(finalIndex, currentIndex)
 			info.RelationFields[field.Name] = finalIndex
+			if rel, ok := parseRelationTag(typ, field); ok {
+				info.TagRelations[field.Name] = rel
+			}
 			continue
 		}
 
 		// Skip fields with zorm:"-"
-		tag := field.Tag.Get("zorm")
 		if tag == "-" {
 			continue
 		}
@@ -402,6 +441,9 @@ func parseFields(typ reflect.Type, info *ModelInfo, indexPrefix []int) {
 		isPrimary := false
 		isAuto := false
 		isVersion := false
+		isUUID := false
+		isJSON := false
+		isArray := false
 
 		// Parse tag
 		if tag != "" {
@@ -428,6 +470,12 @@ func parseFields(typ reflect.Type, info *ModelInfo, indexPrefix []int) {
 					}
 				case "version":
 					isVersion = true
+				case "uuid":
+					isUUID = true
+				case "json":
+					isJSON = true
+				case "array":
+					isArray = true
 				default:
 					// Bare-form shorthand: `zorm:"full_name"` overrides the
 					// column name. Only fires when the token has no `:` and
@@ -452,6 +500,24 @@ func parseFields(typ reflect.Type, info *ModelInfo, indexPrefix []int) {
 			isAuto = true // Default ID to auto-increment
 		}
 
+		// UUID-typed primary keys are generated client-side by Create, so
+		// they are never treated as auto-increment.
+		if isPrimary && isUUIDType(field.Type) {
+			isUUID = true
+		}
+		if isUUID {
+			if !isUUIDType(field.Type) && field.Type.Kind() != reflect.String {
+				panic(fmt.Sprintf("zorm: field %q on %s tagged `uuid` must be a uuid.UUID, *uuid.UUID or string, got %s",
+					field.Name, typ.Name(), field.Type))
+			}
+			isAuto = false
+		}
+
+		if isArray && !isArrayFieldType(field.Type) {
+			panic(fmt.Sprintf("zorm: field %q on %s tagged `array` must be a []string, []int64 or []int, got %s",
+				field.Name, typ.Name(), field.Type))
+		}
+
 		// Override model primary key if found on field
 		if isPrimary {
 			info.PrimaryKey = dbCol
@@ -480,18 +546,27 @@ func parseFields(typ reflect.Type, info *ModelInfo, indexPrefix []int) {
 			offset = field.Offset
 		}
 
+		// A Valuer with a pointer receiver only runs when bound by address.
+		addrValuer := field.Type.Kind() != reflect.Pointer &&
+			!field.Type.Implements(valuerType) && reflect.PointerTo(field.Type).Implements(valuerType)
+
 		fInfo := &FieldInfo{
-			Name:      field.Name,
-			Column:    dbCol,
-			IsPrimary: isPrimary,
-			IsAuto:    isAuto,
-			FieldType: field.Type,
-			Index:     finalIndex,
-			Offset:    offset,
+			Name:       field.Name,
+			Column:     dbCol,
+			IsPrimary:  isPrimary,
+			IsAuto:     isAuto,
+			IsUUID:     isUUID,
+			IsJSON:     isJSON,
+			IsArray:    isArray,
+			addrValuer: addrValuer,
+			FieldType:  field.Type,
+			Index:      finalIndex,
+			Offset:     offset,
 		}
 
 		info.Fields[field.Name] = fInfo
 		info.Columns[dbCol] = fInfo
+		info.FieldOrder = append(info.FieldOrder, fInfo)
 
 		if isVersion {
 			if !isVersionableKind(field.Type.Kind()) {
@@ -507,6 +582,13 @@ func parseFields(typ reflect.Type, info *ModelInfo, indexPrefix []int) {
 	}
 }
 
+var uuidType = reflect.TypeOf(uuid.UUID{})
+
+// isUUIDType reports whether t is uuid.UUID or *uuid.UUID.
+func isUUIDType(t reflect.Type) bool {
+	return t == uuidType || (t.Kind() == reflect.Pointer && t.Elem() == uuidType)
+}
+
 // isIntegerKind reports whether the kind is a signed or unsigned integer.
 // Used by the `primaryKey` shorthand to decide whether to imply auto-increment.
 func isIntegerKind(k reflect.Kind) bool {
@@ -598,9 +680,79 @@ func setInt64Version(v reflect.Value, n int64) {
 	}
 }
 
+// parseRelationTag builds a tagRelation from a relation field's zorm tag,
+// e.g. `zorm:"belongsTo;fk:author_id;ownerKey:id"` or
+// `zorm:"hasMany;fk:user_id;localKey:id"`. It reports false when the tag does
+// not declare a relation and panics on unknown tokens, like column tags.
+func parseRelationTag(typ reflect.Type, field reflect.StructField) (tagRelation, bool) {
+	tag := field.Tag.Get("zorm")
+	if tag == "" || tag == "-" {
+		return tagRelation{}, false
+	}
+
+	related := field.Type
+	if related.Kind() == reflect.Slice {
+		related = related.Elem()
+	}
+	if related.Kind() == reflect.Pointer {
+		related = related.Elem()
+	}
+	rel := tagRelation{Related: related}
+
+	for _, part := range strings.Split(tag, ";") {
+		key, val, _ := strings.Cut(part, ":")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		switch key {
+		case "hasOne":
+			rel.Kind = RelationHasOne
+		case "hasMany":
+			rel.Kind = RelationHasMany
+		case "belongsTo":
+			rel.Kind = RelationBelongsTo
+		case "fk":
+			rel.ForeignKey = val
+		case "localKey":
+			rel.LocalKey = val
+		case "ownerKey":
+			rel.OwnerKey = val
+		case "table":
+			rel.Table = val
+		case "":
+		default:
+			panic(fmt.Sprintf("zorm: field %q on %s: unknown relation tag token %q (use hasOne, hasMany or belongsTo with fk, localKey, ownerKey or table)",
+				field.Name, typ.Name(), part))
+		}
+	}
+	if rel.Kind == "" {
+		panic(fmt.Sprintf("zorm: field %q on %s: relation tag %q must name hasOne, hasMany or belongsTo", field.Name, typ.Name(), tag))
+	}
+	if (rel.Kind == RelationHasMany) != (field.Type.Kind() == reflect.Slice) {
+		panic(fmt.Sprintf("zorm: field %q on %s: %s relation does not match field type %s", field.Name, typ.Name(), rel.Kind, field.Type))
+	}
+	for _, key := range []string{rel.ForeignKey, rel.LocalKey, rel.OwnerKey, rel.Table} {
+		if key == "" {
+			continue
+		}
+		if err := ValidateColumnName(key); err != nil {
+			panic(fmt.Sprintf("zorm: field %q on %s: invalid relation key %q: %v", field.Name, typ.Name(), key, err))
+		}
+	}
+	return rel, true
+}
+
 // timeType is cached to avoid repeated reflect.TypeOf calls.
 var timeType = reflect.TypeOf(time.Time{})
 
+// hasTagToken reports whether a `;`-separated zorm tag contains token.
+func hasTagToken(tag, token string) bool {
+	for _, part := range strings.Split(tag, ";") {
+		if strings.TrimSpace(part) == token {
+			return true
+		}
+	}
+	return false
+}
+
 // isRelationField reports whether t represents a relation field that should be
 // excluded from database queries. Relation fields are:
 //   - Pointers to structs (e.g., *Branch) except *time.Time
@@ -621,9 +773,23 @@ func isRelationField(t reflect.Type) bool {
 }
 
 // isRelationStruct reports whether t is a struct type representing a relation.
-// time.Time is excluded as it's a valid database column type.
+// time.Time and value objects (see isValueObject) are excluded as they are
+// valid database column types.
 func isRelationStruct(t reflect.Type) bool {
-	return t.Kind() == reflect.Struct && t != timeType
+	return t.Kind() == reflect.Struct && t != timeType && !isValueObject(t)
+}
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// isValueObject reports whether t maps to a single column because it (or a
+// pointer to it) implements sql.Scanner or driver.Valuer, like sql.NullString
+// or a domain type such as Money.
+func isValueObject(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return t.Implements(valuerType) || pt.Implements(valuerType) || pt.Implements(scannerType)
 }
 
 // ToSnakeCase converts a string to snake_case.
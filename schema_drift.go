@@ -0,0 +1,217 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// SchemaDiffKind classifies a difference reported by VerifySchema.
+type SchemaDiffKind string
+
+const (
+	// SchemaDiffMissingColumn means the model maps a column the table lacks.
+	SchemaDiffMissingColumn SchemaDiffKind = "missing_column"
+	// SchemaDiffExtraColumn means the table has a column the model does not map.
+	SchemaDiffExtraColumn SchemaDiffKind = "extra_column"
+	// SchemaDiffTypeMismatch means the column's database type cannot hold the
+	// model field's Go type.
+	SchemaDiffTypeMismatch SchemaDiffKind = "type_mismatch"
+)
+
+// SchemaDiff describes one difference between a model and its table.
+// GoType is empty for extra columns; DBType is empty for missing columns.
+type SchemaDiff struct {
+	Table  string
+	Column string
+	Kind   SchemaDiffKind
+	GoType string
+	DBType string
+}
+
+func (d SchemaDiff) String() string {
+	switch d.Kind {
+	case SchemaDiffMissingColumn:
+		return fmt.Sprintf("%s.%s: missing column (model field is %s)", d.Table, d.Column, d.GoType)
+	case SchemaDiffExtraColumn:
+		return fmt.Sprintf("%s.%s: extra column of type %s", d.Table, d.Column, d.DBType)
+	default:
+		return fmt.Sprintf("%s.%s: type mismatch: model field is %s, column is %s", d.Table, d.Column, d.GoType, d.DBType)
+	}
+}
+
+// VerifySchema compares T's mapped columns with the live table and reports
+// missing columns, extra columns and type mismatches. An empty result means
+// no drift was found. It is meant for CI checks and startup validation.
+//
+// Type checks compare broad families (integer, float, text, bool, time,
+// bytes) rather than exact types; columns or fields whose family cannot be
+// determined (e.g. json, custom scanners) are never reported as mismatched.
+// SQLite (pragma table_info) and PostgreSQL (information_schema, current
+// schema unless the table name is schema-qualified) are supported.
+func VerifySchema[T any](ctx context.Context, db *sql.DB) ([]SchemaDiff, error) {
+	if db == nil {
+		return nil, ErrNilDatabase
+	}
+	info := ParseModel[T]()
+
+	dbCols, err := tableColumns(ctx, db, info.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if len(dbCols) == 0 {
+		return nil, fmt.Errorf("zorm: VerifySchema: %w: %q", ErrTableNotFound, info.TableName)
+	}
+
+	dbTypes := make(map[string]string, len(dbCols))
+	for _, c := range dbCols {
+		dbTypes[c.name] = c.dataType
+	}
+
+	var diffs []SchemaDiff
+	modelCols := make([]string, 0, len(info.Columns))
+	for col := range info.Columns {
+		modelCols = append(modelCols, col)
+	}
+	slices.Sort(modelCols)
+	for _, col := range modelCols {
+		goType := info.Columns[col].FieldType
+		dbType, ok := dbTypes[col]
+		if !ok {
+			diffs = append(diffs, SchemaDiff{Table: info.TableName, Column: col, Kind: SchemaDiffMissingColumn, GoType: goType.String()})
+			continue
+		}
+		if !typeFamiliesCompatible(goTypeFamily(goType), dbTypeFamily(dbType)) {
+			diffs = append(diffs, SchemaDiff{Table: info.TableName, Column: col, Kind: SchemaDiffTypeMismatch, GoType: goType.String(), DBType: dbType})
+		}
+	}
+	for _, c := range dbCols {
+		if _, ok := info.Columns[c.name]; !ok {
+			diffs = append(diffs, SchemaDiff{Table: info.TableName, Column: c.name, Kind: SchemaDiffExtraColumn, DBType: c.dataType})
+		}
+	}
+	return diffs, nil
+}
+
+type dbColumn struct {
+	name     string
+	dataType string
+}
+
+// tableColumns lists a table's columns in ordinal order using the
+// dialect's catalog. A table that does not exist yields no columns.
+func tableColumns(ctx context.Context, db *sql.DB, table string) ([]dbColumn, error) {
+	var query string
+	var args []any
+	switch detectDialect(db) {
+	case DialectSQLite:
+		query = "SELECT name, type FROM pragma_table_info(?) ORDER BY cid"
+		args = []any{table}
+	case DialectPostgres:
+		if schema, name, ok := strings.Cut(table, "."); ok {
+			query = "SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = $1 AND table_name = $2 ORDER BY ordinal_position"
+			args = []any{schema, name}
+		} else {
+			query = "SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 ORDER BY ordinal_position"
+			args = []any{table}
+		}
+	default:
+		return nil, fmt.Errorf("zorm: VerifySchema: unsupported dialect %s", detectDialect(db))
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, WrapQueryError("SELECT", query, args, err)
+	}
+	defer rows.Close()
+
+	var cols []dbColumn
+	for rows.Next() {
+		var c dbColumn
+		if err := rows.Scan(&c.name, &c.dataType); err != nil {
+			return nil, err
+		}
+		cols = append(cols, c)
+	}
+	return cols, rows.Err()
+}
+
+var bytesType = reflect.TypeOf([]byte(nil))
+
+// goTypeFamily maps a field type to a coarse storage family, or "" when
+// the type has no obvious column representation.
+func goTypeFamily(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType, reflect.TypeOf(sql.NullTime{}):
+		return "time"
+	case bytesType:
+		return "bytes"
+	case reflect.TypeOf(sql.NullString{}):
+		return "text"
+	case reflect.TypeOf(sql.NullInt64{}), reflect.TypeOf(sql.NullInt32{}), reflect.TypeOf(sql.NullInt16{}), reflect.TypeOf(sql.NullByte{}):
+		return "integer"
+	case reflect.TypeOf(sql.NullFloat64{}):
+		return "float"
+	case reflect.TypeOf(sql.NullBool{}):
+		return "bool"
+	}
+	switch {
+	case isIntegerKind(t.Kind()):
+		return "integer"
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return "float"
+	case t.Kind() == reflect.String:
+		return "text"
+	case t.Kind() == reflect.Bool:
+		return "bool"
+	}
+	return ""
+}
+
+// dbTypeFamily maps a declared column type to a coarse storage family, or
+// "" when unknown. SQLite declarations follow its type-affinity rules.
+func dbTypeFamily(dbType string) string {
+	t := strings.ToLower(dbType)
+	switch {
+	case t == "interval", strings.Contains(t, "point"), strings.Contains(t, "range"):
+		return ""
+	case strings.Contains(t, "bool"):
+		return "bool"
+	case strings.Contains(t, "int"), t == "serial", t == "bigserial", t == "smallserial":
+		return "integer"
+	case strings.Contains(t, "char"), strings.Contains(t, "text"), strings.Contains(t, "clob"), t == "uuid":
+		return "text"
+	case strings.Contains(t, "time"), strings.Contains(t, "date"):
+		return "time"
+	case strings.Contains(t, "real"), strings.Contains(t, "floa"), strings.Contains(t, "doub"),
+		strings.Contains(t, "numeric"), strings.Contains(t, "decimal"):
+		return "float"
+	case strings.Contains(t, "blob"), t == "bytea":
+		return "bytes"
+	}
+	return ""
+}
+
+// typeFamiliesCompatible reports whether a Go family can round-trip through
+// a column family. Unknown families are always compatible. Booleans stored
+// as integers and times stored as text are common enough to accept.
+func typeFamiliesCompatible(goFam, dbFam string) bool {
+	if goFam == "" || dbFam == "" || goFam == dbFam {
+		return true
+	}
+	switch goFam {
+	case "bool":
+		return dbFam == "integer"
+	case "time":
+		return dbFam == "text"
+	case "float":
+		return dbFam == "integer"
+	}
+	return false
+}
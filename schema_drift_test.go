@@ -0,0 +1,93 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type driftModel struct {
+	ID        int `zorm:"primaryKey"`
+	Name      string
+	Email     string
+	Score     float64
+	Active    bool
+	CreatedAt time.Time
+}
+
+func (driftModel) TableName() string { return "drift_models" }
+
+func setupDriftDB(t *testing.T, ddl string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(ddl); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	return db
+}
+
+func TestVerifySchema_NoDrift(t *testing.T) {
+	db := setupDriftDB(t, `
+		CREATE TABLE drift_models (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT,
+			email VARCHAR(255),
+			score REAL,
+			active BOOLEAN,
+			created_at DATETIME
+		);
+	`)
+
+	diffs, err := VerifySchema[driftModel](context.Background(), db)
+	if err != nil {
+		t.Fatalf("VerifySchema failed: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("expected no drift, got %v", diffs)
+	}
+}
+
+func TestVerifySchema_ReportsDrift(t *testing.T) {
+	db := setupDriftDB(t, `
+		CREATE TABLE drift_models (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT,
+			score BLOB,
+			active INTEGER,
+			created_at TEXT,
+			legacy_flag INTEGER
+		);
+	`)
+
+	diffs, err := VerifySchema[driftModel](context.Background(), db)
+	if err != nil {
+		t.Fatalf("VerifySchema failed: %v", err)
+	}
+
+	expected := []SchemaDiff{
+		{Table: "drift_models", Column: "email", Kind: SchemaDiffMissingColumn, GoType: "string"},
+		{Table: "drift_models", Column: "score", Kind: SchemaDiffTypeMismatch, GoType: "float64", DBType: "BLOB"},
+		{Table: "drift_models", Column: "legacy_flag", Kind: SchemaDiffExtraColumn, DBType: "INTEGER"},
+	}
+	if !reflect.DeepEqual(diffs, expected) {
+		t.Errorf("expected diffs %v, got %v", expected, diffs)
+	}
+}
+
+func TestVerifySchema_MissingTable(t *testing.T) {
+	db := setupDriftDB(t, `CREATE TABLE other (id INTEGER);`)
+
+	_, err := VerifySchema[driftModel](context.Background(), db)
+	if !errors.Is(err, ErrTableNotFound) {
+		t.Errorf("expected ErrTableNotFound, got %v", err)
+	}
+}
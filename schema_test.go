@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -433,6 +435,89 @@ func TestParseModel_PrimaryKeyKeyword(t *testing.T) {
 	}
 }
 
+// uuidTagModel has a string column tagged for UUID generation.
+type uuidTagModel struct {
+	ID        int
+	PublicID  string `zorm:"uuid"`
+	AuthorKey uuid.UUID
+}
+
+func TestParseModel_UUIDFields(t *testing.T) {
+	pk := ParseModel[AuthorUUID]().Columns["id"]
+	if !pk.IsUUID || pk.IsAuto {
+		t.Errorf("expected uuid.UUID primary key to be IsUUID and not auto, got IsUUID=%v IsAuto=%v", pk.IsUUID, pk.IsAuto)
+	}
+
+	info := ParseModel[uuidTagModel]()
+	if !info.Columns["public_id"].IsUUID {
+		t.Error("expected `uuid`-tagged string field to be IsUUID")
+	}
+	if info.Columns["author_key"].IsUUID {
+		t.Error("untagged non-key uuid.UUID field must not be IsUUID")
+	}
+	if info.Columns["id"].IsUUID {
+		t.Error("integer ID must not be IsUUID")
+	}
+}
+
+type badUUIDTagModel struct {
+	ID   int
+	Code int `zorm:"uuid"`
+}
+
+func TestParseModel_UUIDTagWrongType_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for `uuid` tag on an int field")
+		}
+	}()
+	ParseModel[badUUIDTagModel]()
+}
+
+func TestParseModel_RelationTags(t *testing.T) {
+	info := ParseModel[BookTagged]()
+	rel, ok := info.TagRelations["Author"]
+	if !ok {
+		t.Fatal("expected a tag relation for Author")
+	}
+	if rel.Kind != RelationBelongsTo || rel.ForeignKey != "author_id" || rel.Related != reflect.TypeOf(AuthorInt{}) {
+		t.Errorf("unexpected tag relation %+v", rel)
+	}
+	if _, ok := info.Columns["author"]; ok {
+		t.Error("relation field must not be mapped as a column")
+	}
+
+	if rel := ParseModel[BookTaggedTable]().TagRelations["Author"]; rel.Table != "authors_int" {
+		t.Errorf("expected table token to set Table, got %q", rel.Table)
+	}
+}
+
+type badRelationTagModel struct {
+	ID    int
+	Posts []*RelPost `zorm:"hasMany;foreign:user_id"`
+}
+
+type mismatchedRelationTagModel struct {
+	ID     int
+	Author *AuthorInt `zorm:"hasMany"`
+}
+
+func TestParseModel_RelationTagErrors_Panic(t *testing.T) {
+	for name, parse := range map[string]func(){
+		"unknown token":      func() { ParseModel[badRelationTagModel]() },
+		"kind/type mismatch": func() { ParseModel[mismatchedRelationTagModel]() },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			parse()
+		}()
+	}
+}
+
 // mixedTagModel mixes a bare-form column name with a keyword flag.
 type mixedTagModel struct {
 	ID  int
@@ -703,3 +788,114 @@ func TestParseModel_BareTagEndToEndCRUD(t *testing.T) {
 		t.Errorf("DB row mismatch: login=%q profile=%q", login, profile)
 	}
 }
+
+type auditFields struct {
+	CreatedBy string
+	UpdatedBy string
+}
+
+type Skipped struct {
+	SkippedField string
+}
+
+type embeddedAuditModel struct {
+	ID int
+	auditFields
+	Skipped `zorm:"-"`
+}
+
+func TestParseModel_UnexportedEmbeddedAndSkip(t *testing.T) {
+	info := ParseModel[embeddedAuditModel]()
+
+	f, ok := info.Columns["created_by"]
+	if !ok {
+		t.Fatal("expected fields of an unexported embedded struct to be columns")
+	}
+	if len(f.Index) != 2 {
+		t.Errorf("expected nested index path, got %v", f.Index)
+	}
+	var m embeddedAuditModel
+	if !reflect.ValueOf(&m).Elem().FieldByIndex(f.Index).CanSet() {
+		t.Error("expected promoted field to be settable through its index")
+	}
+
+	if _, ok := info.Columns["skipped_field"]; ok {
+		t.Error(`expected an embedded struct tagged zorm:"-" to be skipped`)
+	}
+}
+
+// Timestamps is the common embedded audit struct; its columns must take
+// part in INSERT, UPDATE and SELECT like the model's own fields.
+type Timestamps struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type timestampedPost struct {
+	ID    int `zorm:"primaryKey"`
+	Title string
+	Timestamps
+}
+
+func (timestampedPost) TableName() string { return "timestamped_posts" }
+
+func TestEmbeddedTimestamps_EndToEnd(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE timestamped_posts (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT,
+		created_at DATETIME,
+		updated_at DATETIME,
+		deleted_at DATETIME
+	)`); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	ctx := context.Background()
+
+	post := &timestampedPost{Title: "hello"}
+	if err := New[timestampedPost]().SetDB(db).Create(ctx, post); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	defer ClearOriginals(post)
+	if post.CreatedAt.IsZero() || post.UpdatedAt.IsZero() {
+		t.Fatal("expected Create to fill the embedded CreatedAt and UpdatedAt")
+	}
+
+	var stored int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM timestamped_posts WHERE created_at IS NOT NULL AND updated_at IS NOT NULL AND deleted_at IS NULL`).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored != 1 {
+		t.Fatal("expected embedded timestamp columns to be inserted")
+	}
+
+	got, err := New[timestampedPost]().SetDB(db).Find(ctx, post.ID)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	defer ClearOriginals(got)
+	if !got.CreatedAt.Equal(post.CreatedAt) || got.DeletedAt != nil {
+		t.Errorf("scanned timestamps = %+v, want CreatedAt %v and nil DeletedAt", got.Timestamps, post.CreatedAt)
+	}
+
+	deleted := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	got.DeletedAt = &deleted
+	if err := New[timestampedPost]().SetDB(db).Update(ctx, got); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	trashed, err := New[timestampedPost]().SetDB(db).WhereNotNull("deleted_at").Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(trashed) != 1 || trashed[0].DeletedAt == nil || !trashed[0].DeletedAt.Equal(deleted) {
+		t.Errorf("expected the embedded DeletedAt to round-trip, got %+v", trashed)
+	}
+	for _, p := range trashed {
+		ClearOriginals(p)
+	}
+}
@@ -86,24 +86,19 @@ func TestScope_RegisteredBetween(t *testing.T) {
 	}
 }
 
-// TestScope_SearchActiveAdmins documents two zorm quirks that the original
+// TestScope_SearchActiveAdmins documents a zorm quirk that the original
 // scope hits. The scope LOOKS correct, but the emitted SQL is not what you'd
-// expect. Both are real findings in the current codebase (zorm @ main):
+// expect. OrWhere(col, op, val) — the three-arg form — does NOT mirror
+// Where's three-arg form. Where has explicit operator-parsing in case 2;
+// OrWhere calls addWhere directly and skips it. So
+// OrWhere("email", "ILIKE", "%a%") ends up as `email = ?` with TWO trailing
+// args (`"ILIKE"` then `"%a%"`), corrupting the placeholder/arg alignment for
+// everything that follows.
 //
-//  1. OrWhere(col, op, val) — the three-arg form — does NOT mirror Where's
-//     three-arg form. Where has explicit operator-parsing in case 2
-//     (query.go:123-139); OrWhere calls addWhere directly and skips it. So
-//     OrWhere("email", "ILIKE", "%a%") ends up as `email = ?` with TWO trailing
-//     args (`"ILIKE"` then `"%a%"`), corrupting the placeholder/arg alignment
-//     for everything that follows.
-//
-//  2. The Where(func(q){...}) grouping helper strips both "AND " and "OR "
-//     prefixes from each nested condition and joins them with a single space
-//     (query.go:163-169). So a nested `Where(...).OrWhere(...)` produces
-//     `(condA condB)` with no connector — invalid SQL.
+// The Where(func(q){...}) group keeps the nested OR between its conditions.
 //
 // The assertions below intentionally lock down the *actual* (buggy) output
-// emitted today, so this test will fail loudly if zorm fixes either quirk —
+// emitted today, so this test will fail loudly if zorm fixes the quirk —
 // at which point the scope can be revisited.
 func TestScope_SearchActiveAdmins(t *testing.T) {
 	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
@@ -120,9 +115,9 @@ func TestScope_SearchActiveAdmins(t *testing.T) {
 		}
 	}
 
-	// Quirk #1+#2 manifested: the OR is silently dropped and the second
-	// clause loses its ILIKE operator (collapses to `email = $5`).
-	const buggyGroup = "(name ILIKE $4 email = $5)"
+	// The quirk manifested: the second clause loses its ILIKE operator
+	// (collapses to `email = $5`).
+	const buggyGroup = "(name ILIKE $4 OR email = $5)"
 	if !strings.Contains(sql, buggyGroup) {
 		t.Errorf("expected the (known-buggy) group %q in SQL, got %q", buggyGroup, sql)
 	}
@@ -131,7 +126,7 @@ func TestScope_SearchActiveAdmins(t *testing.T) {
 		t.Errorf("expected 'ORDER BY last_login DESC', got %q", sql)
 	}
 
-	// Quirk #1 manifests in the args: the literal string "ILIKE" leaks in
+	// It also manifests in the args: the literal string "ILIKE" leaks in
 	// as a value at index 4, shifting the real email pattern to index 5.
 	wantArgs := []any{"admin", true, since, "%alice%", "ILIKE", "%alice%"}
 	if len(args) != len(wantArgs) {
@@ -173,3 +168,36 @@ func TestScope_ChainedWithOtherBuilders(t *testing.T) {
 		t.Fatalf("expected 3 args, got %d (%v)", len(args), args)
 	}
 }
+
+// TestScope_WhenUnless verifies When/Unless only apply scopes when the
+// condition allows it, and accept several scopes at once.
+func TestScope_WhenUnless(t *testing.T) {
+	sql, args := New[ScopeUser]().
+		When(true, Role("admin")).
+		When(false, Role("guest")).
+		Print()
+	if !strings.Contains(sql, "role = $1") || strings.Contains(sql, "$2") {
+		t.Errorf("expected only the admin scope, got %q", sql)
+	}
+	if len(args) != 1 || args[0] != "admin" {
+		t.Errorf("expected args [admin], got %v", args)
+	}
+
+	sql, args = New[ScopeUser]().
+		Unless(false, Role("editor"), func(q *Model[ScopeUser]) *Model[ScopeUser] {
+			return q.Where("active", true)
+		}).
+		Unless(true, Role("guest")).
+		Print()
+	if !strings.Contains(sql, "role = $1") || !strings.Contains(sql, "active = $2") {
+		t.Errorf("expected both Unless scopes applied, got %q", sql)
+	}
+	if len(args) != 2 {
+		t.Errorf("expected 2 args, got %v", args)
+	}
+
+	base, _ := New[ScopeUser]().Print()
+	if sql, _ := New[ScopeUser]().When(false, Role("admin")).Print(); sql != base {
+		t.Errorf("expected unchanged query when condition is false, got %q", sql)
+	}
+}
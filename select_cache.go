@@ -0,0 +1,111 @@
+package zorm
+
+// selectQueryCache memoizes buildSelectQuery output for a builder that is
+// executed repeatedly without changes. Besides the result it keeps the
+// builder state the SQL was generated from; an entry is only reused while
+// that state is unchanged, so mutating builder methods invalidate it without
+// having to mark the builder dirty themselves.
+//
+// Slices are compared by length and backing array. Builder methods only ever
+// append to (or replace) these slices, and reset() drops the cache before it
+// truncates them, so equal length and array mean equal contents. Holding the
+// slices here keeps their arrays alive, so an address cannot be reused by a
+// later allocation while the entry exists.
+type selectQueryCache struct {
+	query string
+	args  []any
+
+	tableName   string
+	distinct    bool
+	stableOrder bool
+	lockMode    string
+	limit       int
+	offset      int
+	columns     []string
+	distinctOn  []string
+	selectExprs []string
+	selectArgs  []any
+	wheres      []string
+	whereArgs   []any
+	groupBys    []string
+	havings     []string
+	havingArgs  []any
+	orderBys    []string
+	orderArgs   []any
+	joins       []joinClause
+	ctes        []CTE
+}
+
+// sameSlice reports whether a and b have the same length and backing array.
+func sameSlice[E any](a, b []E) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
+
+// cacheableSelect reports whether buildSelectQuery output may be memoized.
+// CTEs built from other builders are excluded because those builders can
+// change independently of m.
+func (m *Model[T]) cacheableSelect() bool {
+	for _, cte := range m.ctes {
+		if _, ok := cte.Query.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Model[T]) newSelectQueryCache(query string, args []any) *selectQueryCache {
+	return &selectQueryCache{
+		query:       query,
+		args:        args,
+		tableName:   m.tableName,
+		distinct:    m.distinct,
+		stableOrder: m.stableOrder,
+		lockMode:    m.lockMode,
+		limit:       m.limit,
+		offset:      m.offset,
+		columns:     m.columns,
+		distinctOn:  m.distinctOn,
+		selectExprs: m.selectExprs,
+		selectArgs:  m.selectArgs,
+		wheres:      m.wheres,
+		whereArgs:   m.args,
+		groupBys:    m.groupBys,
+		havings:     m.havings,
+		havingArgs:  m.havingArgs,
+		orderBys:    m.orderBys,
+		orderArgs:   m.orderArgs,
+		joins:       m.joins,
+		ctes:        m.ctes,
+	}
+}
+
+// cachedSelect returns the memoized SELECT for m's current state, or nil
+// when there is none or the builder changed since it was built.
+func (m *Model[T]) cachedSelect() *selectQueryCache {
+	c := m.selectCache.Load()
+	if c == nil {
+		return nil
+	}
+	if c.tableName == m.tableName &&
+		c.distinct == m.distinct &&
+		c.stableOrder == m.stableOrder &&
+		c.lockMode == m.lockMode &&
+		c.limit == m.limit &&
+		c.offset == m.offset &&
+		sameSlice(c.columns, m.columns) &&
+		sameSlice(c.distinctOn, m.distinctOn) &&
+		sameSlice(c.selectExprs, m.selectExprs) &&
+		sameSlice(c.selectArgs, m.selectArgs) &&
+		sameSlice(c.wheres, m.wheres) &&
+		sameSlice(c.whereArgs, m.args) &&
+		sameSlice(c.groupBys, m.groupBys) &&
+		sameSlice(c.havings, m.havings) &&
+		sameSlice(c.havingArgs, m.havingArgs) &&
+		sameSlice(c.orderBys, m.orderBys) &&
+		sameSlice(c.orderArgs, m.orderArgs) &&
+		sameSlice(c.joins, m.joins) &&
+		sameSlice(c.ctes, m.ctes) {
+		return c
+	}
+	return nil
+}
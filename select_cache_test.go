@@ -0,0 +1,93 @@
+package zorm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSelectCache_ReusedUntilMutation(t *testing.T) {
+	m := New[TestModel]().Where("name", "alice").OrderBy("id", "ASC")
+
+	q1, args1 := m.buildSelectQuery()
+	c := m.selectCache.Load()
+	if c == nil {
+		t.Fatal("expected buildSelectQuery to populate the cache")
+	}
+	q2, _ := m.buildSelectQuery()
+	if q1 != q2 || m.selectCache.Load() != c {
+		t.Error("expected the cached entry to be reused for an unchanged builder")
+	}
+
+	// Appending to returned args must not leak into the cache.
+	_ = append(args1, "extra")
+	if _, args := m.buildSelectQuery(); len(args) != 1 {
+		t.Errorf("expected cached args to stay [alice], got %v", args)
+	}
+
+	mutations := []struct {
+		name   string
+		mutate func()
+		want   string
+	}{
+		{"Where", func() { m.Where("user_age", ">", 18) }, "user_age >"},
+		{"Limit", func() { m.Limit(10) }, "LIMIT 10"},
+		{"Offset", func() { m.Offset(20) }, "OFFSET 20"},
+		{"OrderBy", func() { m.OrderBy("name", "DESC") }, "name DESC"},
+		{"Select", func() { m.Select("id") }, "SELECT id"},
+		{"Distinct", func() { m.Distinct() }, "SELECT DISTINCT"},
+	}
+	for _, mu := range mutations {
+		mu.mutate()
+		if q, _ := m.buildSelectQuery(); !strings.Contains(q, mu.want) {
+			t.Errorf("%s: expected rebuilt query to contain %q, got %q", mu.name, mu.want, q)
+		}
+	}
+}
+
+func TestSelectCache_RestoreAndChunkPages(t *testing.T) {
+	m := New[TestModel]().Where("name", "alice")
+	state := m.Snapshot()
+	m.Where("user_age", 30)
+	if q, _ := m.buildSelectQuery(); !strings.Contains(q, "user_age") {
+		t.Fatalf("expected user_age condition, got %q", q)
+	}
+
+	m.Restore(state)
+	if q, args := m.buildSelectQuery(); strings.Contains(q, "user_age") || len(args) != 1 {
+		t.Errorf("expected Restore to invalidate the cache, got %q %v", q, args)
+	}
+
+	// Changing limit/offset between runs, as Chunk does, must not serve
+	// a stale page.
+	for page := 1; page <= 3; page++ {
+		m.Limit(50).Offset((page - 1) * 50)
+		q, _ := m.buildSelectQuery()
+		if page > 1 && !strings.Contains(q, fmt.Sprintf("OFFSET %d", (page-1)*50)) {
+			t.Errorf("page %d: expected OFFSET %d, got %q", page, (page-1)*50, q)
+		}
+	}
+}
+
+func BenchmarkBuildSelectQuery(b *testing.B) {
+	m := New[TestModel]().
+		Select("id", "name", "user_age").
+		Where("name", "alice").
+		Where("user_age", ">", 18).
+		WhereIn("id", []any{1, 2, 3, 4, 5}).
+		OrderBy("id", "ASC").
+		Limit(100)
+
+	b.Run("Cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m.buildSelectQuery()
+		}
+	})
+	b.Run("Uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m.renderSelectQuery()
+		}
+	})
+}
@@ -10,8 +10,36 @@ import (
 
 // stmtShardCount is the number of shards for the statement cache.
 // Using 64 shards provides good distribution while keeping memory overhead low.
+// Caches smaller than this use one shard per statement slot.
 const stmtShardCount = 64
 
+// defaultStmtCacheCapacity is the capacity of caches created by
+// NewStmtCache with a capacity of 0 or less. See ConfigureStmtCache.
+var defaultStmtCacheCapacity atomic.Int64
+
+func init() {
+	defaultStmtCacheCapacity.Store(100)
+}
+
+// ConfigureStmtCache sets the capacity used by NewStmtCache when it is
+// called with a capacity of 0 or less. A capacity of 0 or less restores the
+// default of 100. Caches that already exist keep their capacity.
+//
+// Every cached statement holds a server-side prepared statement, so keep
+// the capacity below the database's limit (e.g. max_prepared_stmt_count on
+// MySQL) divided by the number of caches and connections.
+//
+// Example:
+//
+//	zorm.ConfigureStmtCache(500)
+//	cache := zorm.NewStmtCache(0) // holds at most 500 statements
+func ConfigureStmtCache(capacity int) {
+	if capacity <= 0 {
+		capacity = 100
+	}
+	defaultStmtCacheCapacity.Store(int64(capacity))
+}
+
 // StmtCache provides a thread-safe LRU cache for prepared statements.
 // It stores prepared SQL statements and automatically evicts the least
 // recently used entries when the cache reaches its maximum capacity.
@@ -21,7 +49,7 @@ const stmtShardCount = 64
 // improve performance by reusing prepared statements instead of re-preparing
 // them on every execution.
 type StmtCache struct {
-	shards   [stmtShardCount]*stmtCacheShard
+	shards   []*stmtCacheShard
 	capacity int
 	closed   atomic.Bool // Set to true after Close/Clear to signal release() to close stmts directly
 }
@@ -45,25 +73,29 @@ type cacheEntry struct {
 
 // NewStmtCache creates a new statement cache with the specified capacity.
 // When the cache reaches capacity, the least recently used statement will
-// be evicted to make room for new entries.
+// be evicted, and closed once no caller is using it, to make room for new
+// entries. The cache never holds more than capacity statements.
 //
-// A capacity of 0 or negative value will default to 100.
+// A capacity of 0 or negative value will default to 100, or to the value
+// set with ConfigureStmtCache.
 func NewStmtCache(capacity int) *StmtCache {
 	if capacity <= 0 {
-		capacity = 100
-	}
-
-	// Distribute capacity across shards
-	shardCapacity := capacity / stmtShardCount
-	if shardCapacity < 1 {
-		shardCapacity = 1
+		capacity = int(defaultStmtCacheCapacity.Load())
 	}
 
+	// Distribute capacity across shards, spreading the remainder so the
+	// shard capacities add up to exactly capacity.
+	n := min(capacity, stmtShardCount)
 	c := &StmtCache{
+		shards:   make([]*stmtCacheShard, n),
 		capacity: capacity,
 	}
 
-	for i := 0; i < stmtShardCount; i++ {
+	for i := range c.shards {
+		shardCapacity := capacity / n
+		if i < capacity%n {
+			shardCapacity++
+		}
 		c.shards[i] = &stmtCacheShard{
 			capacity: shardCapacity,
 			items:    make(map[string]*cacheEntry),
@@ -78,7 +110,7 @@ func NewStmtCache(capacity int) *StmtCache {
 func (c *StmtCache) getShard(query string) *stmtCacheShard {
 	h := fnv.New32a()
 	h.Write([]byte(query))
-	return c.shards[h.Sum32()%stmtShardCount]
+	return c.shards[h.Sum32()%uint32(len(c.shards))]
 }
 
 // Get retrieves a cached prepared statement for the given SQL query.
@@ -196,8 +228,7 @@ func (c *StmtCache) release(shard *stmtCacheShard, entry *cacheEntry) {
 
 // Clear closes all cached statements and clears the cache.
 func (c *StmtCache) Clear() {
-	for i := 0; i < stmtShardCount; i++ {
-		shard := c.shards[i]
+	for _, shard := range c.shards {
 		shard.mu.Lock()
 
 		for _, entry := range shard.items {
@@ -225,8 +256,7 @@ func (c *StmtCache) Close() error {
 // Len returns the current number of cached statements.
 func (c *StmtCache) Len() int {
 	total := 0
-	for i := 0; i < stmtShardCount; i++ {
-		shard := c.shards[i]
+	for _, shard := range c.shards {
 		shard.mu.Lock()
 		total += len(shard.items)
 		shard.mu.Unlock()
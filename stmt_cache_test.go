@@ -1,6 +1,7 @@
 package zorm
 
 import (
+	"database/sql"
 	"fmt"
 	"sync"
 	"testing"
@@ -121,6 +122,78 @@ func TestStmtCache_Eviction(t *testing.T) {
 	}
 }
 
+func TestStmtCache_EvictionClosesStatementsAndStaysBounded(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	const capacity = 10
+	cache := NewStmtCache(capacity)
+	defer cache.Close()
+
+	stmts := make([]*sql.Stmt, 50)
+	for i := range stmts {
+		query := fmt.Sprintf("SELECT %d", i)
+		stmt, err := db.Prepare(query)
+		if err != nil {
+			t.Fatalf("prepare %q: %v", query, err)
+		}
+		stmts[i] = stmt
+		cache.Put(query, stmt)
+		if n := cache.Len(); n > capacity {
+			t.Fatalf("after %d statements the cache holds %d, want at most %d", i+1, n, capacity)
+		}
+	}
+	if n := cache.Len(); n != capacity {
+		t.Errorf("expected a full cache of %d statements, got %d", capacity, n)
+	}
+
+	// Every statement that is no longer cached has been closed.
+	var closed int
+	for i, stmt := range stmts {
+		_, release := cache.Get(fmt.Sprintf("SELECT %d", i))
+		if release != nil {
+			release()
+			if _, err := stmt.Exec(); err != nil {
+				t.Errorf("cached statement %d: %v", i, err)
+			}
+			continue
+		}
+		if _, err := stmt.Exec(); err == nil {
+			t.Errorf("evicted statement %d was not closed", i)
+		}
+		closed++
+	}
+	if closed != len(stmts)-capacity {
+		t.Errorf("expected %d closed statements, got %d", len(stmts)-capacity, closed)
+	}
+}
+
+func TestConfigureStmtCache(t *testing.T) {
+	t.Cleanup(func() { ConfigureStmtCache(0) })
+
+	ConfigureStmtCache(3)
+	cache := NewStmtCache(0)
+	for i := 0; i < 20; i++ {
+		cache.Put(fmt.Sprintf("SELECT %d", i), nil)
+	}
+	if n := cache.Len(); n != 3 {
+		t.Errorf("expected the configured capacity of 3, got %d", n)
+	}
+
+	ConfigureStmtCache(0)
+	cache = NewStmtCache(0)
+	for i := 0; i < 200; i++ {
+		cache.Put(fmt.Sprintf("SELECT %d", i), nil)
+	}
+	// Shards fill unevenly, so only the upper bound is exact.
+	if n := cache.Len(); n > 100 || n <= 3 {
+		t.Errorf("expected the default capacity of 100 to apply, got %d statements", n)
+	}
+}
+
 // =============================================================================
 // ISSUE #7: STMTCACHE CLOSE WITH IN-FLIGHT STATEMENTS
 // =============================================================================
@@ -0,0 +1,136 @@
+package zorm
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// TimeLayoutRFC3339Nano is RFC 3339 with a fixed nine-digit fraction. Unlike
+// time.RFC3339Nano it keeps trailing zeros, so values written in UTC sort
+// chronologically as text.
+const TimeLayoutRFC3339Nano = "2006-01-02T15:04:05.000000000Z07:00"
+
+var (
+	timeLayoutsMu sync.RWMutex
+	timeLayouts   = map[Dialect]string{}
+)
+
+// SetTimeLayout makes ZORM store time.Time fields as text in the given
+// layout for a dialect, for databases such as SQLite that keep timestamps
+// in TEXT columns. Create, Update, CreateMany and UpsertMany format field
+// values in UTC with the layout, and scans parse text columns back into
+// time.Time and *time.Time fields. Pass an empty layout to restore the
+// default of handing time.Time to the driver unchanged.
+//
+// Only entity fields are converted: time.Time values passed to Where and
+// friends are bound as-is, so format them with the same layout when
+// comparing against text columns.
+//
+// Example:
+//
+//	zorm.SetTimeLayout(zorm.DialectSQLite, zorm.TimeLayoutRFC3339Nano)
+func SetTimeLayout(d Dialect, layout string) {
+	timeLayoutsMu.Lock()
+	defer timeLayoutsMu.Unlock()
+	if layout == "" {
+		delete(timeLayouts, d)
+		return
+	}
+	timeLayouts[d] = layout
+}
+
+// TimeLayout returns the text layout set for the dialect with SetTimeLayout,
+// or "" when times are passed to the driver unchanged.
+func (d Dialect) TimeLayout() string {
+	timeLayoutsMu.RLock()
+	defer timeLayoutsMu.RUnlock()
+	return timeLayouts[d]
+}
+
+// encodeTime formats time.Time and non-nil *time.Time values with layout.
+// Other values, and every value when layout is empty, are returned unchanged.
+func encodeTime(v any, layout string) any {
+	if layout == "" {
+		return v
+	}
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC().Format(layout)
+	case *time.Time:
+		if t != nil {
+			return t.UTC().Format(layout)
+		}
+	}
+	return v
+}
+
+// isTimeField reports whether a field holds a time.Time or *time.Time.
+func isTimeField(t reflect.Type) bool {
+	return t == timeType || (t.Kind() == reflect.Pointer && t.Elem() == timeType)
+}
+
+// textTimeLayouts are tried after the configured layout when parsing a
+// text timestamp, so rows written before SetTimeLayout still scan.
+var textTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// timeScanner scans a time column into a time.Time or *time.Time field,
+// accepting native time values as well as text in the configured layout.
+type timeScanner struct {
+	dst    reflect.Value
+	layout string
+}
+
+func (s *timeScanner) Scan(src any) error {
+	var t time.Time
+	switch v := src.(type) {
+	case nil:
+		s.dst.Set(reflect.Zero(s.dst.Type()))
+		return nil
+	case time.Time:
+		t = v
+	case string:
+		parsed, err := parseTextTime(v, s.layout)
+		if err != nil {
+			return err
+		}
+		t = parsed
+	case []byte:
+		parsed, err := parseTextTime(string(v), s.layout)
+		if err != nil {
+			return err
+		}
+		t = parsed
+	default:
+		return fmt.Errorf("zorm: cannot scan %T into %s", src, s.dst.Type())
+	}
+	if s.dst.Kind() == reflect.Pointer {
+		s.dst.Set(reflect.ValueOf(&t))
+	} else {
+		s.dst.Set(reflect.ValueOf(t))
+	}
+	return nil
+}
+
+func parseTextTime(s, layout string) (time.Time, error) {
+	if t, err := time.Parse(layout, s); err == nil {
+		return t, nil
+	}
+	for _, l := range textTimeLayouts {
+		if t, err := time.Parse(l, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("zorm: cannot parse %q as a time in layout %q", s, layout)
+}
+
+// timeLayout returns the text layout for the model's dialect.
+func (m *Model[T]) timeLayout() string {
+	return m.effectiveDialect().TimeLayout()
+}
@@ -0,0 +1,85 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type textTimeModel struct {
+	ID        int `zorm:"primaryKey"`
+	HappensAt time.Time
+	EndsAt    *time.Time
+}
+
+func (textTimeModel) TableName() string { return "text_times" }
+
+func TestSetTimeLayout_RoundTripsNanoseconds(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE text_times (id INTEGER PRIMARY KEY AUTOINCREMENT, happens_at TEXT, ends_at TEXT)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	SetTimeLayout(DialectSQLite, TimeLayoutRFC3339Nano)
+	t.Cleanup(func() { SetTimeLayout(DialectSQLite, "") })
+
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 30, 45, 123456789, time.FixedZone("CEST", 2*60*60))
+	m := &textTimeModel{HappensAt: at}
+	if err := New[textTimeModel]().SetDB(db).Create(ctx, m); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	var raw string
+	if err := db.QueryRow(`SELECT happens_at FROM text_times WHERE id = ?`, m.ID).Scan(&raw); err != nil {
+		t.Fatalf("failed to read raw value: %v", err)
+	}
+	if raw != "2025-06-01T10:30:45.123456789Z" {
+		t.Errorf("expected UTC RFC 3339 text, got %q", raw)
+	}
+
+	got, err := New[textTimeModel]().SetDB(db).Find(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if !got.HappensAt.Equal(at) || got.HappensAt.Nanosecond() != 123456789 {
+		t.Errorf("expected %v, got %v", at, got.HappensAt)
+	}
+	if got.EndsAt != nil {
+		t.Errorf("expected nil EndsAt, got %v", got.EndsAt)
+	}
+
+	ends := at.Add(90 * time.Minute)
+	got.EndsAt = &ends
+	if err := New[textTimeModel]().SetDB(db).Update(ctx, got); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	got, err = New[textTimeModel]().SetDB(db).Find(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("Find after update failed: %v", err)
+	}
+	if got.EndsAt == nil || !got.EndsAt.Equal(ends) {
+		t.Errorf("expected EndsAt %v, got %v", ends, got.EndsAt)
+	}
+}
+
+func TestEncodeTime(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got := encodeTime(at, TimeLayoutRFC3339Nano); got != "2025-01-02T03:04:05.000000000Z" {
+		t.Errorf("expected fixed-width text, got %v", got)
+	}
+	if got := encodeTime(at, ""); got != at {
+		t.Errorf("expected time unchanged without a layout, got %v", got)
+	}
+	var nilTime *time.Time
+	if got := encodeTime(nilTime, TimeLayoutRFC3339Nano); got != nilTime {
+		t.Errorf("expected nil pointer unchanged, got %v", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"reflect"
 )
 
 // Tx wraps sql.Tx.
@@ -83,3 +84,80 @@ func (m *Model[T]) WithTx(tx *Tx) *Model[T] {
 	clone.ctx = tx.ctx
 	return clone
 }
+
+// Batch queues writes on a model to run in order inside one transaction.
+// Create one with Model.Batch, queue operations with Create, Update and
+// Delete, then call Execute.
+type Batch[T any] struct {
+	model *Model[T]
+	ops   []func(ctx context.Context, m *Model[T]) error
+}
+
+// Batch returns an empty Batch that runs its operations through m.
+//
+// Example:
+//
+//	err := New[User]().Batch().
+//	    Create(&newUser).
+//	    Update(existing).
+//	    Delete(stale).
+//	    Execute(ctx)
+func (m *Model[T]) Batch() *Batch[T] {
+	return &Batch[T]{model: m}
+}
+
+// Create queues an insert of entity.
+func (b *Batch[T]) Create(entity *T) *Batch[T] {
+	b.ops = append(b.ops, func(ctx context.Context, m *Model[T]) error {
+		return m.Create(ctx, entity)
+	})
+	return b
+}
+
+// Update queues an update of entity by its primary key.
+func (b *Batch[T]) Update(entity *T) *Batch[T] {
+	b.ops = append(b.ops, func(ctx context.Context, m *Model[T]) error {
+		return m.Update(ctx, entity)
+	})
+	return b
+}
+
+// Delete queues a delete of the row with entity's primary key. Any WHERE
+// conditions already on the model still apply.
+func (b *Batch[T]) Delete(entity *T) *Batch[T] {
+	b.ops = append(b.ops, func(ctx context.Context, m *Model[T]) error {
+		if entity == nil {
+			return ErrNilPointer
+		}
+		pk := m.modelInfo.PrimaryKey
+		field, ok := m.modelInfo.Columns[pk]
+		if !ok {
+			return fmt.Errorf("zorm: %w: no primary key column %q", ErrInvalidModel, pk)
+		}
+		id := reflect.ValueOf(entity).Elem().FieldByIndex(field.Index).Interface()
+		return m.Clone().Where(pk, id).Delete(ctx)
+	})
+	return b
+}
+
+// Execute runs the queued operations in order inside a single transaction,
+// stopping at and returning the first error, in which case nothing is
+// committed. When the model is already bound to a transaction with WithTx,
+// the operations join it instead of starting a new one.
+func (b *Batch[T]) Execute(ctx context.Context) error {
+	if len(b.ops) == 0 {
+		return nil
+	}
+	run := func(m *Model[T]) error {
+		for i, op := range b.ops {
+			if err := op(ctx, m); err != nil {
+				return fmt.Errorf("zorm: batch operation %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+	if b.model.tx != nil {
+		return run(b.model)
+	}
+	return b.model.withAutoTx(ctx, run)
+}
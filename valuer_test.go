@@ -0,0 +1,120 @@
+package zorm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Money is a struct value object stored as text like "1250 EUR". Both
+// methods have pointer receivers, so a Money field must be bound by address.
+type Money struct {
+	Cents    int64
+	Currency string
+}
+
+func (m *Money) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d %s", m.Cents, m.Currency), nil
+}
+
+func (m *Money) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+	_, err := fmt.Sscanf(text, "%d %s", &m.Cents, &m.Currency)
+	return err
+}
+
+// Email normalizes to lower case when written.
+type Email string
+
+func (e Email) Value() (driver.Value, error) {
+	return strings.ToLower(string(e)), nil
+}
+
+func (e *Email) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		*e = Email(v)
+	case []byte:
+		*e = Email(v)
+	default:
+		return fmt.Errorf("email: cannot scan %T", src)
+	}
+	return nil
+}
+
+type valuerInvoice struct {
+	ID      int `zorm:"primaryKey"`
+	Total   Money
+	Refund  *Money
+	Contact Email
+}
+
+func (valuerInvoice) TableName() string { return "valuer_invoices" }
+
+func TestValueObjects_RoundTrip(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE valuer_invoices (id INTEGER PRIMARY KEY AUTOINCREMENT, total TEXT, refund TEXT, contact TEXT)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	ctx := context.Background()
+
+	info := ParseModel[valuerInvoice]()
+	if _, ok := info.Columns["refund"]; !ok {
+		t.Fatal("expected a *Money field to be a column, not a relation")
+	}
+
+	inv := &valuerInvoice{Total: Money{1250, "EUR"}, Contact: "Ann@Example.COM"}
+	if err := New[valuerInvoice]().SetDB(db).Create(ctx, inv); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer ClearOriginals(inv)
+
+	var total, contact string
+	var refund sql.NullString
+	if err := db.QueryRow(`SELECT total, refund, contact FROM valuer_invoices WHERE id = ?`, inv.ID).Scan(&total, &refund, &contact); err != nil {
+		t.Fatalf("failed to read raw values: %v", err)
+	}
+	if total != "1250 EUR" || refund.Valid || contact != "ann@example.com" {
+		t.Errorf("stored total=%q refund=%v contact=%q", total, refund, contact)
+	}
+
+	got, err := New[valuerInvoice]().SetDB(db).Find(ctx, inv.ID)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	defer ClearOriginals(got)
+	if got.Total != inv.Total || got.Refund != nil || got.Contact != "ann@example.com" {
+		t.Errorf("scanned %+v", got)
+	}
+
+	got.Refund = &Money{300, "EUR"}
+	got.Total.Cents = 950
+	if err := New[valuerInvoice]().SetDB(db).Save(ctx, got); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	saved, err := New[valuerInvoice]().SetDB(db).Find(ctx, inv.ID)
+	if err != nil {
+		t.Fatalf("Find after Save failed: %v", err)
+	}
+	defer ClearOriginals(saved)
+	if saved.Total != (Money{950, "EUR"}) || saved.Refund == nil || *saved.Refund != (Money{300, "EUR"}) {
+		t.Errorf("after Save got total=%+v refund=%+v", saved.Total, saved.Refund)
+	}
+}